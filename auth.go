@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/qvntm/accord/pb"
@@ -17,14 +18,19 @@ import (
 type AuthServer struct {
 	mutex      sync.RWMutex
 	users      map[string]*User
+	storage    Storage
 	jwtManager *JWTManager
+	denylist   *TokenDenylist
 }
 
-// NewAuthServer returns a new auth server
-func NewAuthServer() *AuthServer {
+// NewAuthServer returns a new auth server, which persists users in the given
+// storage and issues tokens with the JWT manager
+func NewAuthServer(storage Storage, jwtManager *JWTManager) *AuthServer {
 	return &AuthServer{
 		users:      make(map[string]*User),
-		jwtManager: NewJWTManager(secretKey, tokenDuration),
+		storage:    storage,
+		jwtManager: jwtManager,
+		denylist:   NewTokenDenylist(storage, jwtManager.refreshTokenDuration),
 	}
 }
 
@@ -32,6 +38,11 @@ func (s *AuthServer) JWTManager() *JWTManager {
 	return s.jwtManager
 }
 
+// Denylist returns the list of tokens revoked by logging out.
+func (s *AuthServer) Denylist() *TokenDenylist {
+	return s.denylist
+}
+
 func (s *AuthServer) GetUser(username string) *User {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
@@ -44,16 +55,41 @@ func (s *AuthServer) GetUser(username string) *User {
 	return user.Clone()
 }
 
-func (s *AuthServer) CreateUser(_ context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	if s.users[req.GetUsername()] != nil {
-		return nil, status.Errorf(codes.AlreadyExists, "Username is already in use")
+// loadUsers replaces all the users of the auth server with the ones from the storage.
+func (s *AuthServer) loadUsers() error {
+	records, err := s.storage.Users()
+	if err != nil {
+		return err
 	}
 
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.users = make(map[string]*User, len(records))
+	for _, record := range records {
+		s.users[record.Username] = newUserFromRecord(record)
+	}
+	return nil
+}
+
+func (s *AuthServer) CreateUser(_ context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	// the password is hashed before the lock is taken, since hashing is slow
 	user, err := NewUser(req.GetUsername(), req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Password could not be hashed")
 	}
 
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.users[req.GetUsername()] != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "Username is already in use")
+	}
+
+	if err := s.storage.SaveUser(user.record()); err != nil {
+		log.Printf("Failed to save user %s: %v", user.username, err)
+		return nil, status.Errorf(codes.Internal, "Cannot save the user")
+	}
 	s.users[user.username] = user
 
 	res := &pb.CreateUserResponse{}
@@ -71,19 +107,125 @@ func (s *AuthServer) Login(_ context.Context, req *pb.LoginRequest) (*pb.LoginRe
 		return nil, status.Errorf(codes.InvalidArgument, "Incorrect password.")
 	}
 
-	token, err := s.jwtManager.Generate(user.username)
+	accessToken, refreshToken, err := s.generateTokens(user.username)
 	if err != nil {
 		log.Print("token generation failed!")
 		return nil, status.Errorf(codes.Internal, "Cannot generate access token")
 	}
 
-	res := &pb.LoginResponse{AccessToken: token}
+	res := &pb.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
 	log.Printf("%s acquired new token", user.username)
 	return res, nil
 }
 
-func (s *AuthServer) Logout(_ context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Unimplemented!")
+// Refresh exchanges the refresh token for new access and refresh tokens.
+// The refresh token is revoked, so that it can be used only once.
+func (s *AuthServer) Refresh(_ context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	claims, err := s.jwtManager.VerifyRefresh(req.GetRefreshToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token is invalid: %v", err)
+	}
+	if s.GetUser(claims.Username) == nil {
+		return nil, status.Errorf(codes.NotFound, "Username not found.")
+	}
+	revoked, err := s.denylist.RevokeOnce(claims)
+	if err != nil {
+		log.Printf("Cannot revoke refresh token of %s: %v", claims.Username, err)
+		return nil, status.Errorf(codes.Internal, "cannot revoke the refresh token")
+	}
+	if !revoked {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token has been revoked")
+	}
+
+	accessToken, refreshToken, err := s.generateTokens(claims.Username)
+	if err != nil {
+		log.Print("token generation failed!")
+		return nil, status.Errorf(codes.Internal, "Cannot generate access token")
+	}
+
+	res := &pb.RefreshResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	log.Printf("%s refreshed token", claims.Username)
+	return res, nil
+}
+
+// generateTokens generates new access and refresh tokens for the user, which
+// can be revoked by logging out of all sessions.
+func (s *AuthServer) generateTokens(username string) (string, string, error) {
+	accessToken, accessClaims, err := s.jwtManager.generate(username, false)
+	if err != nil {
+		return "", "", err
+	}
+	refreshToken, refreshClaims, err := s.jwtManager.generate(username, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	s.denylist.Issue(accessClaims)
+	s.denylist.Issue(refreshClaims)
+	return accessToken, refreshToken, nil
+}
+
+// Logout revokes the given access token, or all the tokens of its user if
+// requested. Only tokens of the authenticated user can be revoked.
+func (s *AuthServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims, err := s.jwtManager.Verify(req.GetAccessToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "access token is invalid: %v", err)
+	}
+	if claims.Username != username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot revoke tokens of another user")
+	}
+	var refreshClaims *UserClaims
+	if req.GetRefreshToken() != "" {
+		refreshClaims, err = s.jwtManager.VerifyRefresh(req.GetRefreshToken())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "refresh token is invalid: %v", err)
+		}
+		if refreshClaims.Username != username {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot revoke tokens of another user")
+		}
+	}
+
+	if req.GetAllSessions() {
+		err = s.denylist.RevokeAll(username)
+	}
+	if err == nil {
+		err = s.denylist.Revoke(claims)
+	}
+	if err == nil && refreshClaims != nil {
+		err = s.denylist.Revoke(refreshClaims)
+	}
+	if err != nil {
+		log.Printf("Cannot revoke tokens of %s: %v", username, err)
+		return nil, status.Errorf(codes.Internal, "cannot revoke the tokens")
+	}
+
+	res := &pb.LogoutResponse{}
+	log.Printf("%s logged out", username)
+	return res, nil
+}
+
+// GetKeySet returns the public keys, which verify tokens issued by the server.
+func (s *AuthServer) GetKeySet(_ context.Context, req *pb.GetKeySetRequest) (*pb.GetKeySetResponse, error) {
+	set := s.jwtManager.PublicKeySet()
+	res := &pb.GetKeySetResponse{
+		Keys: make([]*pb.JSONWebKey, 0, len(set.Keys)),
+	}
+	for _, key := range set.Keys {
+		res.Keys = append(res.Keys, getPBJSONWebKey(key))
+	}
+	return res, nil
 }
 
 // AuthClient is a client to call authentication RPC
@@ -112,8 +254,8 @@ func (c *AuthClient) CreateUser(username string, password string) error {
 	return err
 }
 
-// Login login user and returns the access token
-func (c *AuthClient) Login(username string, password string) (string, error) {
+// Login login user and returns the access and refresh tokens
+func (c *AuthClient) Login(username string, password string) (string, string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -124,8 +266,60 @@ func (c *AuthClient) Login(username string, password string) (string, error) {
 
 	res, err := c.AuthServiceClient.Login(ctx, req)
 	if err != nil {
-		return "", err
+		return "", "", err
+	}
+
+	return res.GetAccessToken(), res.GetRefreshToken(), nil
+}
+
+// Refresh exchanges the refresh token for new access and refresh tokens
+func (c *AuthClient) Refresh(refreshToken string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := &pb.RefreshRequest{
+		RefreshToken: refreshToken,
+	}
+
+	res, err := c.AuthServiceClient.Refresh(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+
+	return res.GetAccessToken(), res.GetRefreshToken(), nil
+}
+
+// Logout revokes the access and refresh tokens, or all the tokens of their
+// user if allSessions is set. The refresh token may be empty.
+func (c *AuthClient) Logout(accessToken string, refreshToken string, allSessions bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// the auth service isn't called through the auth interceptor
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", accessToken)
+
+	req := &pb.LogoutRequest{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		AllSessions:  allSessions,
+	}
+
+	_, err := c.AuthServiceClient.Logout(ctx, req)
+	return err
+}
+
+// GetKeySet returns the public keys, which verify tokens issued by the server
+func (c *AuthClient) GetKeySet() (JSONWebKeySet, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.AuthServiceClient.GetKeySet(ctx, &pb.GetKeySetRequest{})
+	if err != nil {
+		return JSONWebKeySet{}, err
 	}
 
-	return res.GetAccessToken(), nil
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(res.GetKeys()))}
+	for _, key := range res.GetKeys() {
+		set.Keys = append(set.Keys, getJSONWebKey(key))
+	}
+	return set, nil
 }
@@ -2,28 +2,75 @@ package accord
 
 import (
 	"context"
-	"fmt"
+	"crypto/x509"
 	"log"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// refreshThreshold is the part of the access token's lifetime, which
+	// is left when the client refreshes the token
+	refreshThreshold = 0.2
+	// refreshRetryDuration is the minimal time between refreshes
+	refreshRetryDuration = time.Second
+)
+
+// claimsContextKey is the key of verified UserClaims in contexts of handlers.
+type claimsContextKey struct{}
+
+func contextWithClaims(ctx context.Context, claims *UserClaims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the user, who has been authenticated
+// by ServerAuthInterceptor. Unlike metadata, they cannot be set by clients.
+func ClaimsFromContext(ctx context.Context) (*UserClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*UserClaims)
+	return claims, ok && claims != nil
+}
+
+// authenticatedServerStream is the stream, whose context carries the claims
+// of the authenticated user.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // ServerAuthInterceptor is a server interceptor for authentication and authorization
 type ServerAuthInterceptor struct {
 	jwtManager *JWTManager
+	denylist   *TokenDenylist
+	// certUsername maps verified client certificates to usernames, it is nil
+	// if client certificate authentication is disabled
+	certUsername func(cert *x509.Certificate) (string, error)
 }
 
-// NewServerAuthInterceptor returns a new auth interceptor
-func NewServerAuthInterceptor(jwtManager *JWTManager) *ServerAuthInterceptor {
+// NewServerAuthInterceptor returns a new auth interceptor, which rejects tokens from the denylist
+func NewServerAuthInterceptor(jwtManager *JWTManager, denylist *TokenDenylist) *ServerAuthInterceptor {
 	return &ServerAuthInterceptor{
 		jwtManager: jwtManager,
+		denylist:   denylist,
 	}
 }
 
+// EnableClientCertificates authenticates clients, which don't send a token but
+// have a verified client certificate, as the user returned by certUsername.
+func (interceptor *ServerAuthInterceptor) EnableClientCertificates(certUsername func(cert *x509.Certificate) (string, error)) {
+	interceptor.certUsername = certUsername
+}
+
 // Unary returns a server interceptor function to authenticate and authorize unary RPC
 func (interceptor *ServerAuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(
@@ -40,8 +87,7 @@ func (interceptor *ServerAuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		}
 
 		if claims != nil {
-			md := metadata.Pairs("username", claims.Username)
-			ctx = metadata.NewIncomingContext(ctx, md)
+			ctx = contextWithClaims(ctx, claims)
 		}
 
 		return handler(ctx, req)
@@ -64,12 +110,10 @@ func (interceptor *ServerAuthInterceptor) Stream() grpc.StreamServerInterceptor
 		}
 
 		if claims != nil {
-			md, ok := metadata.FromIncomingContext(stream.Context())
-			if !ok {
-				return fmt.Errorf("could not extract metadata from outgoing context")
+			stream = &authenticatedServerStream{
+				ServerStream: stream,
+				ctx:          contextWithClaims(stream.Context(), claims),
 			}
-			md.Append("username", claims.Username)
-			stream.SetHeader(md)
 		}
 
 		return handler(srv, stream)
@@ -77,7 +121,8 @@ func (interceptor *ServerAuthInterceptor) Stream() grpc.StreamServerInterceptor
 }
 
 func (interceptor *ServerAuthInterceptor) Authorize(ctx context.Context, method string) (*UserClaims, error) {
-	if method == "/accord.AuthService/CreateUser" || method == "/accord.AuthService/Login" {
+	switch method {
+	case "/accord.AuthService/CreateUser", "/accord.AuthService/Login", "/accord.AuthService/Refresh", "/accord.AuthService/GetKeySet":
 		return nil, nil
 	}
 
@@ -88,6 +133,9 @@ func (interceptor *ServerAuthInterceptor) Authorize(ctx context.Context, method
 
 	values := md["authorization"]
 	if len(values) == 0 {
+		if cert := clientCertificate(ctx); cert != nil && interceptor.certUsername != nil {
+			return interceptor.authorizeCertificate(cert)
+		}
 		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
 	}
 
@@ -96,36 +144,67 @@ func (interceptor *ServerAuthInterceptor) Authorize(ctx context.Context, method
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
 	}
+	if interceptor.denylist.IsRevoked(claims) {
+		return nil, status.Errorf(codes.Unauthenticated, "access token has been revoked")
+	}
 
 	return claims, nil
 }
 
+func (interceptor *ServerAuthInterceptor) authorizeCertificate(cert *x509.Certificate) (*UserClaims, error) {
+	username, err := interceptor.certUsername(cert)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "client certificate is not accepted: %v", err)
+	}
+	return &UserClaims{Username: username}, nil
+}
+
+// clientCertificate returns the client's certificate if it has been verified
+// during the TLS handshake, or nil.
+func clientCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
+
 // ClientAuthInterceptor is a client interceptor for authentication
 type ClientAuthInterceptor struct {
-	authClient  *AuthClient
-	username    string
-	password    string
-	accessToken string
+	authClient *AuthClient
+	// mutex guards the tokens, which are replaced by the refresh loop
+	mutex        sync.RWMutex
+	accessToken  string
+	refreshToken string
+	// donec is closed to stop the refresh loop
+	donec    chan struct{}
+	stopOnce sync.Once
 }
 
-// NewClientAuthInterceptor returns a new auth interceptor
+// NewClientAuthInterceptor logs the user in and returns a new auth interceptor,
+// which keeps the access token fresh. The password is not kept.
 func NewClientAuthInterceptor(
 	authClient *AuthClient,
 	username string,
 	password string,
-	refreshDuration time.Duration,
 ) (*ClientAuthInterceptor, error) {
-	interceptor := &ClientAuthInterceptor{
-		authClient: authClient,
-		username:   username,
-		password:   password,
-	}
-
-	err := interceptor.scheduleRefreshToken(refreshDuration)
+	accessToken, refreshToken, err := authClient.Login(username, password)
 	if err != nil {
 		return nil, err
 	}
 
+	interceptor := &ClientAuthInterceptor{
+		authClient:   authClient,
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+		donec:        make(chan struct{}),
+	}
+	go interceptor.refreshLoop()
+
 	return interceptor, nil
 }
 
@@ -162,39 +241,82 @@ func (intr *ClientAuthInterceptor) Stream() grpc.StreamClientInterceptor {
 }
 
 func (intr *ClientAuthInterceptor) attachToken(ctx context.Context) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "authorization", intr.accessToken)
+	return metadata.AppendToOutgoingContext(ctx, "authorization", intr.AccessToken())
 }
 
-func (intr *ClientAuthInterceptor) scheduleRefreshToken(refreshDuration time.Duration) error {
-	err := intr.refreshToken()
-	if err != nil {
-		return err
-	}
+// AccessToken returns the current access token.
+func (intr *ClientAuthInterceptor) AccessToken() string {
+	intr.mutex.RLock()
+	defer intr.mutex.RUnlock()
+	return intr.accessToken
+}
 
-	go func() {
-		wait := refreshDuration
-		for {
-			time.Sleep(wait)
-			err := intr.refreshToken()
-			if err != nil {
-				wait = time.Second
-			} else {
-				wait = refreshDuration
-			}
+// RefreshToken returns the current refresh token.
+func (intr *ClientAuthInterceptor) RefreshToken() string {
+	intr.mutex.RLock()
+	defer intr.mutex.RUnlock()
+	return intr.refreshToken
+}
+
+// Stop stops refreshing the access token. It is safe to call it more than once.
+func (intr *ClientAuthInterceptor) Stop() {
+	intr.stopOnce.Do(func() {
+		close(intr.donec)
+	})
+}
+
+// refreshLoop refreshes the access token some time before it expires, until
+// the interceptor is stopped or the refresh token is rejected.
+func (intr *ClientAuthInterceptor) refreshLoop() {
+	wait := intr.refreshWait()
+	for {
+		select {
+		case <-intr.donec:
+			return
+		case <-time.After(wait):
 		}
-	}()
 
-	return nil
+		err := intr.refresh()
+		switch status.Code(err) {
+		case codes.OK:
+			wait = intr.refreshWait()
+		case codes.Unauthenticated, codes.NotFound:
+			log.Printf("Stopping token refresh: %v", err)
+			return
+		default:
+			wait = refreshRetryDuration
+		}
+	}
+}
+
+// refreshWait returns how long to wait before refreshing the current access
+// token, which is refreshed when refreshThreshold of its lifetime is left.
+func (intr *ClientAuthInterceptor) refreshWait() time.Duration {
+	claims, err := parseUnverified(intr.AccessToken())
+	if err != nil {
+		log.Printf("Cannot parse access token: %v", err)
+		return refreshRetryDuration
+	}
+
+	issuedAt, expiresAt := time.Unix(claims.IssuedAt, 0), time.Unix(claims.ExpiresAt, 0)
+	refreshAt := expiresAt.Add(-time.Duration(float64(expiresAt.Sub(issuedAt)) * refreshThreshold))
+	if wait := time.Until(refreshAt); wait > refreshRetryDuration {
+		return wait
+	}
+	return refreshRetryDuration
 }
 
-func (intr *ClientAuthInterceptor) refreshToken() error {
-	accessToken, err := intr.authClient.Login(intr.username, intr.password)
+func (intr *ClientAuthInterceptor) refresh() error {
+	accessToken, refreshToken, err := intr.authClient.Refresh(intr.RefreshToken())
 	if err != nil {
 		return err
 	}
 
+	intr.mutex.Lock()
 	intr.accessToken = accessToken
-	log.Printf("token refreshed: %v", accessToken)
+	intr.refreshToken = refreshToken
+	intr.mutex.Unlock()
+	log.Print("token refreshed")
 
 	return nil
 }
@@ -0,0 +1,559 @@
+package accord
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	usersBucket = []byte("users")
+	// channelsBucket keeps channels, and its sequence is the Id, which will
+	// be assigned to the next created channel
+	channelsBucket     = []byte("channels")
+	channelUsersBucket = []byte("channel_users")
+	messagesBucket     = []byte("messages")
+	bansBucket         = []byte("bans")
+	// membershipRequestsBucket keeps pending invites and requests to join
+	// channels, keys are usernames
+	membershipRequestsBucket = []byte("membership_requests")
+	// inviteCodesBucket keeps invite codes of channels, keys are the codes
+	inviteCodesBucket = []byte("invite_codes")
+	// threadsBucket indexes replies by their threads, keys are Ids of the
+	// thread's root and of the reply, and values are empty
+	threadsBucket = []byte("threads")
+	// revokedTokensBucket keeps tokens revoked before they expire, keys are
+	// Ids of the tokens
+	revokedTokensBucket = []byte("revoked_tokens")
+	// userRevocationsBucket keeps revocations of all the sessions of users,
+	// keys are usernames
+	userRevocationsBucket = []byte("user_revocations")
+)
+
+// channelBuckets contain a nested bucket for each channel.
+var channelBuckets = [][]byte{channelUsersBucket, messagesBucket, bansBucket, threadsBucket, membershipRequestsBucket, inviteCodesBucket}
+
+// BoltStorage is a Storage backed by an embedded on-disk bbolt database.
+type BoltStorage struct {
+	db *bolt.DB
+}
+
+// NewBoltStorage opens the database at the given path, creating it if it
+// doesn't exist yet.
+func NewBoltStorage(path string) (*BoltStorage, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open database %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range append([][]byte{usersBucket, channelsBucket, revokedTokensBucket, userRevocationsBucket}, channelBuckets...) {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		// databases created before the sequence was kept continue after
+		// the greatest Id of their channels
+		channels := tx.Bucket(channelsBucket)
+		if key, _ := channels.Cursor().Last(); key != nil && channels.Sequence() == 0 {
+			return channels.SetSequence(binary.BigEndian.Uint64(key) + 1)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot initialize database %s: %w", path, err)
+	}
+
+	return &BoltStorage{db: db}, nil
+}
+
+func uint64ToKey(v uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, v)
+	return key
+}
+
+func threadKey(threadRootID uint64, messageID uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, threadRootID)
+	binary.BigEndian.PutUint64(key[8:], messageID)
+	return key
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put(key, data)
+}
+
+func (s *BoltStorage) SaveUser(user *UserRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(usersBucket), []byte(user.Username), user)
+	})
+}
+
+func (s *BoltStorage) Users() ([]*UserRecord, error) {
+	var users []*UserRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(usersBucket).ForEach(func(_, v []byte) error {
+			user := &UserRecord{}
+			if err := json.Unmarshal(v, user); err != nil {
+				return err
+			}
+			users = append(users, user)
+			return nil
+		})
+	})
+	return users, err
+}
+
+func (s *BoltStorage) NextChannelID() (uint64, error) {
+	var id uint64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		channels := tx.Bucket(channelsBucket)
+		id = channels.Sequence()
+		return channels.SetSequence(id + 1)
+	})
+	return id, err
+}
+
+func (s *BoltStorage) SaveChannel(channel *ChannelRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		key := uint64ToKey(channel.ChannelID)
+		for _, name := range channelBuckets {
+			if _, err := tx.Bucket(name).CreateBucketIfNotExists(key); err != nil {
+				return err
+			}
+		}
+		return putJSON(tx.Bucket(channelsBucket), key, channel)
+	})
+}
+
+func (s *BoltStorage) RemoveChannel(channelID uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		key := uint64ToKey(channelID)
+		for _, name := range channelBuckets {
+			if err := tx.Bucket(name).DeleteBucket(key); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		}
+		return tx.Bucket(channelsBucket).Delete(key)
+	})
+}
+
+func (s *BoltStorage) Channels() ([]*ChannelRecord, error) {
+	var channels []*ChannelRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(channelsBucket).ForEach(func(_, v []byte) error {
+			channel := &ChannelRecord{}
+			if err := json.Unmarshal(v, channel); err != nil {
+				return err
+			}
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	return channels, err
+}
+
+func (s *BoltStorage) SaveChannelUser(channelID uint64, user *ChannelUserRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(channelUsersBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return putJSON(b, []byte(user.Username), user)
+	})
+}
+
+func (s *BoltStorage) RemoveChannelUser(channelID uint64, username string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(channelUsersBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(username))
+	})
+}
+
+func (s *BoltStorage) ChannelUsers(channelID uint64) ([]*ChannelUserRecord, error) {
+	var users []*ChannelUserRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(channelUsersBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return b.ForEach(func(_, v []byte) error {
+			user := &ChannelUserRecord{}
+			if err := json.Unmarshal(v, user); err != nil {
+				return err
+			}
+			users = append(users, user)
+			return nil
+		})
+	})
+	return users, err
+}
+
+func (s *BoltStorage) AppendMessage(channelID uint64, msg *MessageRecord) (uint64, error) {
+	var messageID uint64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(messagesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		// sequence of the bucket is never decremented, so Ids are never reused
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		record := *msg
+		record.MessageID = id
+		if err := putJSON(b, uint64ToKey(id), &record); err != nil {
+			return err
+		}
+		if record.ThreadRootID != 0 {
+			// the bucket is missing for channels created by older versions
+			threads, err := tx.Bucket(threadsBucket).CreateBucketIfNotExists(uint64ToKey(channelID))
+			if err != nil {
+				return err
+			}
+			if err := threads.Put(threadKey(record.ThreadRootID, id), []byte{}); err != nil {
+				return err
+			}
+		}
+		messageID = id
+		return nil
+	})
+	return messageID, err
+}
+
+func (s *BoltStorage) UpdateMessage(channelID uint64, msg *MessageRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(messagesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		key := uint64ToKey(msg.MessageID)
+		if b.Get(key) == nil {
+			return fmt.Errorf("message with id %d doesn't exist in channel %d", msg.MessageID, channelID)
+		}
+		return putJSON(b, key, msg)
+	})
+}
+
+func (s *BoltStorage) Message(channelID uint64, messageID uint64) (*MessageRecord, error) {
+	msg := &MessageRecord{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(messagesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		data := b.Get(uint64ToKey(messageID))
+		if data == nil {
+			return fmt.Errorf("message with id %d doesn't exist in channel %d", messageID, channelID)
+		}
+		return json.Unmarshal(data, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (s *BoltStorage) Messages(channelID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error) {
+	var records []*MessageRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(messagesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		inRange := func(k []byte) bool {
+			if k == nil {
+				return false
+			}
+			id := binary.BigEndian.Uint64(k)
+			return id > afterID && (beforeID == 0 || id < beforeID)
+		}
+
+		c := b.Cursor()
+		var k, v []byte
+		if newest {
+			if beforeID == 0 {
+				k, v = c.Last()
+			} else if k, v = c.Seek(uint64ToKey(beforeID)); k == nil {
+				k, v = c.Last()
+			} else {
+				k, v = c.Prev()
+			}
+		} else {
+			k, v = c.Seek(uint64ToKey(afterID + 1))
+		}
+		for ; inRange(k) && len(records) < limit; k, v = nextOrPrev(c, newest) {
+			msg := &MessageRecord{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+			if !msg.Deleted {
+				records = append(records, msg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newest {
+		reverseMessageRecords(records)
+	}
+	return records, nil
+}
+
+func (s *BoltStorage) ThreadMessages(channelID uint64, threadRootID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error) {
+	var records []*MessageRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(messagesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		threads := tx.Bucket(threadsBucket).Bucket(uint64ToKey(channelID))
+		if threads == nil {
+			// nobody has replied in the channel yet
+			return nil
+		}
+		prefix := uint64ToKey(threadRootID)
+		inRange := func(k []byte) bool {
+			if k == nil || !bytes.HasPrefix(k, prefix) {
+				return false
+			}
+			id := binary.BigEndian.Uint64(k[8:])
+			return id > afterID && (beforeID == 0 || id < beforeID)
+		}
+
+		c := threads.Cursor()
+		var k []byte
+		if newest {
+			end := threadKey(threadRootID+1, 0)
+			if beforeID != 0 {
+				end = threadKey(threadRootID, beforeID)
+			}
+			if k, _ = c.Seek(end); k == nil {
+				k, _ = c.Last()
+			} else {
+				k, _ = c.Prev()
+			}
+		} else {
+			k, _ = c.Seek(threadKey(threadRootID, afterID+1))
+		}
+		for ; inRange(k) && len(records) < limit; k, _ = nextOrPrev(c, newest) {
+			data := b.Get(k[8:])
+			if data == nil {
+				return fmt.Errorf("reply %d is missing in channel %d", binary.BigEndian.Uint64(k[8:]), channelID)
+			}
+			msg := &MessageRecord{}
+			if err := json.Unmarshal(data, msg); err != nil {
+				return err
+			}
+			if !msg.Deleted {
+				records = append(records, msg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newest {
+		reverseMessageRecords(records)
+	}
+	return records, nil
+}
+
+func nextOrPrev(c *bolt.Cursor, prev bool) ([]byte, []byte) {
+	if prev {
+		return c.Prev()
+	}
+	return c.Next()
+}
+
+func (s *BoltStorage) SaveBan(channelID uint64, ban *BanRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bansBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return putJSON(b, []byte(ban.Username), ban)
+	})
+}
+
+func (s *BoltStorage) RemoveBan(channelID uint64, username string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bansBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(username))
+	})
+}
+
+func (s *BoltStorage) Bans(channelID uint64) ([]*BanRecord, error) {
+	var bans []*BanRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bansBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return b.ForEach(func(_, v []byte) error {
+			ban := &BanRecord{}
+			if err := json.Unmarshal(v, ban); err != nil {
+				return err
+			}
+			bans = append(bans, ban)
+			return nil
+		})
+	})
+	return bans, err
+}
+
+func (s *BoltStorage) SaveMembershipRequest(channelID uint64, request *MembershipRequestRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(membershipRequestsBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return putJSON(b, []byte(request.Username), request)
+	})
+}
+
+func (s *BoltStorage) RemoveMembershipRequest(channelID uint64, username string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(membershipRequestsBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(username))
+	})
+}
+
+func (s *BoltStorage) MembershipRequests(channelID uint64) ([]*MembershipRequestRecord, error) {
+	var requests []*MembershipRequestRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(membershipRequestsBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return b.ForEach(func(_, v []byte) error {
+			request := &MembershipRequestRecord{}
+			if err := json.Unmarshal(v, request); err != nil {
+				return err
+			}
+			requests = append(requests, request)
+			return nil
+		})
+	})
+	return requests, err
+}
+
+func (s *BoltStorage) SaveInviteCode(channelID uint64, code *InviteCodeRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(inviteCodesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return putJSON(b, []byte(code.Code), code)
+	})
+}
+
+func (s *BoltStorage) RemoveInviteCode(channelID uint64, code string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(inviteCodesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(code))
+	})
+}
+
+func (s *BoltStorage) InviteCodes(channelID uint64) ([]*InviteCodeRecord, error) {
+	var codes []*InviteCodeRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(inviteCodesBucket).Bucket(uint64ToKey(channelID))
+		if b == nil {
+			return fmt.Errorf("channel with id %d doesn't exist", channelID)
+		}
+		return b.ForEach(func(_, v []byte) error {
+			code := &InviteCodeRecord{}
+			if err := json.Unmarshal(v, code); err != nil {
+				return err
+			}
+			codes = append(codes, code)
+			return nil
+		})
+	})
+	return codes, err
+}
+
+func (s *BoltStorage) SaveRevokedToken(token *RevokedTokenRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(revokedTokensBucket), []byte(token.ID), token)
+	})
+}
+
+func (s *BoltStorage) RemoveRevokedToken(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(revokedTokensBucket).Delete([]byte(id))
+	})
+}
+
+func (s *BoltStorage) RevokedTokens() ([]*RevokedTokenRecord, error) {
+	var tokens []*RevokedTokenRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(revokedTokensBucket).ForEach(func(_, v []byte) error {
+			token := &RevokedTokenRecord{}
+			if err := json.Unmarshal(v, token); err != nil {
+				return err
+			}
+			tokens = append(tokens, token)
+			return nil
+		})
+	})
+	return tokens, err
+}
+
+func (s *BoltStorage) SaveUserRevocation(revocation *UserRevocationRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putJSON(tx.Bucket(userRevocationsBucket), []byte(revocation.Username), revocation)
+	})
+}
+
+func (s *BoltStorage) RemoveUserRevocation(username string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(userRevocationsBucket).Delete([]byte(username))
+	})
+}
+
+func (s *BoltStorage) UserRevocations() ([]*UserRevocationRecord, error) {
+	var revocations []*UserRevocationRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(userRevocationsBucket).ForEach(func(_, v []byte) error {
+			revocation := &UserRevocationRecord{}
+			if err := json.Unmarshal(v, revocation); err != nil {
+				return err
+			}
+			revocations = append(revocations, revocation)
+			return nil
+		})
+	})
+	return revocations, err
+}
+
+func (s *BoltStorage) Close() error {
+	return s.db.Close()
+}
@@ -1,19 +1,54 @@
 package accord
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"math/rand"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	pb "github.com/qvntm/accord/pb"
 )
 
+const (
+	// maxEmojiLength is the maximal length of emoji in bytes, which is
+	// enough for sequences of several code points
+	maxEmojiLength = 64
+	// maxReactionsPerMessage is the maximal number of distinct emoji, which
+	// the message can be reacted with
+	maxReactionsPerMessage = 50
+	// typingTimeout is how long the typing signal lasts unless it is repeated
+	typingTimeout = 6 * time.Second
+	// typingThrottle is the minimal time between typing signals of a single
+	// user, which are sent to others. It is less than typingTimeout, so that
+	// users, who keep typing, are shown as typing all the time.
+	typingThrottle = 2 * time.Second
+)
+
 type channelUser struct {
 	user *User
 	role Role
+	// lastReadID is the Id of the last message read by the user
+	lastReadID uint64
+}
+
+// channelStreamRequest is a request received from the stream of a user, which
+// is passed to the channel for processing.
+type channelStreamRequest struct {
+	username string
+	req      *pb.ChannelStreamRequest
+	// errc receives the error, with which the request has been rejected or
+	// has failed, or nil. It has to be buffered.
+	errc chan error
 }
 
 // ClientChannel represents a single private or public messaging channel.
@@ -25,25 +60,83 @@ type ClientChannel struct {
 	// and messages, is not polled through "IsFetched".
 	IsFetched           bool
 	IsPublic            bool
+	IsDirect            bool
 	PinnedMsgId         uint64
 	Users               map[string]Role
+	Presences           map[string]Presence
 	RolesWithPermission map[Permission][]Role
-	Stream              pb.Chat_ChannelStreamClient
-	Messages            []Message
+	// Roles contains both built-in and custom roles of the channel.
+	Roles map[Role]RoleDefinition
+	// Bans are only fetched for users, who can kick or ban in the channel.
+	Bans     map[string]Ban
+	Stream   pb.Chat_ChannelStreamClient
+	Messages []Message
+	// LastReadID, UnreadCount and MentionCount are fetched with the list of
+	// channels and are only known for channels, which the user is in.
+	LastReadID   uint64
+	UnreadCount  int32
+	MentionCount int32
+	// Threads are keyed by Ids of their first messages. They are known for
+	// fetched messages, which have replies, and for fetched threads.
+	Threads map[uint64]*Thread
+	// Invites and JoinRequests are pending, and they are only fetched for
+	// users, who can assign roles in the channel.
+	Invites      map[string]Invite
+	JoinRequests map[string]JoinRequest
+	// ResumeAfterID is set when the server closes the stream, since the client
+	// couldn't keep up with the channel. Messages after it have to be fetched
+	// with GetMessages. It is set before Resc of the stream is closed.
+	ResumeAfterID uint64
 }
 
 // ServerChannel represents a single private or public messaging channel.
+//
+// The state of the channel is owned by its listen goroutine. Others access it
+// only through do, except before listen is started and after it is stopped.
 type ServerChannel struct {
 	channelId uint64
 	name      string
-	msgc      chan *pb.ChannelStreamRequest
+	msgc      chan *channelStreamRequest
+	// funcc receives functions, which are run by listen
+	funcc chan func()
 	// users contains general information about users in the channel
 	users map[string]*channelUser
 	// usersToStreams has only streams of users, which are streaming at the moment
-	usersToStreams      map[string]pb.Chat_ChannelStreamServer
+	usersToStreams map[string]*channelStream
+	// streamsMutex guards usersToStreams
+	streamsMutex        sync.Mutex
 	pinnedMsgId         uint64
 	isPublic            bool
 	rolesWithPermission map[Permission][]Role
+	// customRoles maps roles defined by superadmins of the channel to their names
+	customRoles map[Role]string
+	// bans maps banned usernames to their bans, expired bans may be kept
+	bans map[string]*BanRecord
+	// pending maps usernames to their invites and requests to join the channel
+	pending map[string]*MembershipRequestRecord
+	// inviteCodes maps codes, which let users join the channel, to their records
+	inviteCodes map[string]*InviteCodeRecord
+	// isDirect is set for direct channels, which cannot be joined by others
+	isDirect bool
+	// storage is where all the changes of the channel are written through
+	storage Storage
+	// serverStreams is notified about changes of the channel, which are visible
+	// to all the users of the server. It may be nil.
+	serverStreams *serverStreamRegistry
+	// queues configures queues of streams with the channel
+	queues *streamQueues
+	// typing maps users, who have signaled typing, to the times the signals
+	// expire. Expired signals are pruned periodically. It is only accessed by
+	// listen.
+	typing map[string]time.Time
+	// ctx is canceled when the channel is removed, which stops listen
+	ctx    context.Context
+	cancel context.CancelFunc
+	// stopped is closed when listen returns
+	stopped chan struct{}
+	// removed is closed after streams with the removed channel have been
+	// sent the final event, so that they can terminate
+	removed chan struct{}
 }
 
 // NewClientChannel creates a new client channel with provided parameters.
@@ -53,116 +146,1138 @@ func NewClientChannel(uid uint64, name string, isPublic bool) *ClientChannel {
 		Name:      name,
 		IsPublic:  isPublic,
 		IsFetched: false,
+		Users:     make(map[string]Role),
+		Presences: make(map[string]Presence),
+		Threads:   make(map[uint64]*Thread),
 	}
 }
 
+// updateThread updates the thread started by the fetched message, if the
+// message has been replied to.
+func (ch *ClientChannel) updateThread(msg Message) {
+	thread, ok := ch.Threads[msg.MessageID]
+	if !ok {
+		if msg.ReplyCount == 0 {
+			return
+		}
+		thread = &Thread{RootID: msg.MessageID}
+		ch.Threads[msg.MessageID] = thread
+	}
+	thread.ReplyCount = msg.ReplyCount
+	thread.LastReplyAt = msg.LastReplyAt
+}
+
 // NewServerChannel creates a new server channel with provided parameters.
-func NewServerChannel(uid uint64, name string, isPublic bool) *ServerChannel {
+// All the changes of the channel are persisted in the storage.
+func NewServerChannel(storage Storage, uid uint64, name string, isPublic bool) *ServerChannel {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &ServerChannel{
 		channelId:           uid,
 		name:                name,
-		msgc:                make(chan *pb.ChannelStreamRequest),
+		msgc:                make(chan *channelStreamRequest),
+		funcc:               make(chan func()),
 		users:               make(map[string]*channelUser),
+		usersToStreams:      make(map[string]*channelStream),
 		pinnedMsgId:         0,
 		isPublic:            isPublic,
-		rolesWithPermission: make(map[Permission][]Role),
+		rolesWithPermission: cloneRolesWithPermission(defaultRolesWithPermission),
+		customRoles:         make(map[Role]string),
+		bans:                make(map[string]*BanRecord),
+		pending:             make(map[string]*MembershipRequestRecord),
+		inviteCodes:         make(map[string]*InviteCodeRecord),
+		storage:             storage,
+		queues:              newStreamQueues(DefaultServerConfig().Streams),
+		typing:              make(map[string]time.Time),
+		ctx:                 ctx,
+		cancel:              cancel,
+		stopped:             make(chan struct{}),
+		removed:             make(chan struct{}),
 	}
 }
 
-func (ch *ServerChannel) addUser(user *channelUser) {
+// newServerChannelFromRecord restores the channel from its persistent representation.
+// Members of the channel have to be added separately.
+func newServerChannelFromRecord(storage Storage, record *ChannelRecord) *ServerChannel {
+	ch := NewServerChannel(storage, record.ChannelID, record.Name, record.IsPublic)
+	ch.pinnedMsgId = record.PinnedMsgID
+	if len(record.RolesWithPermission) != 0 {
+		ch.rolesWithPermission = cloneRolesWithPermission(record.RolesWithPermission)
+	}
+	ch.customRoles = cloneCustomRoles(record.CustomRoles)
+	ch.isDirect = record.IsDirect
+	return ch
+}
+
+func (ch *ServerChannel) record() *ChannelRecord {
+	return &ChannelRecord{
+		ChannelID:           ch.channelId,
+		Name:                ch.name,
+		IsPublic:            ch.isPublic,
+		PinnedMsgID:         ch.pinnedMsgId,
+		RolesWithPermission: cloneRolesWithPermission(ch.rolesWithPermission),
+		CustomRoles:         cloneCustomRoles(ch.customRoles),
+		IsDirect:            ch.isDirect,
+	}
+}
+
+// directKey identifies the direct channel of the users.
+func directKey(usernames []string) string {
+	sorted := append([]string(nil), usernames...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, "\x00")
+}
+
+// members returns usernames of all members of the channel.
+func (ch *ServerChannel) members() []string {
+	usernames := make([]string, 0, len(ch.users))
+	for username := range ch.users {
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
+// addUser adds the user to the channel or updates the role of the user,
+// who is already in the channel.
+func (ch *ServerChannel) addUser(user *channelUser) error {
+	record := &ChannelUserRecord{
+		Username:   user.user.username,
+		Role:       user.role,
+		LastReadID: user.lastReadID,
+	}
+	if err := ch.storage.SaveChannelUser(ch.channelId, record); err != nil {
+		return fmt.Errorf("cannot save user %s in channel %d: %w", record.Username, ch.channelId, err)
+	}
+
 	ch.users[user.user.username] = user
+	return nil
+}
+
+// removeUser removes the user from the channel.
+func (ch *ServerChannel) removeUser(username string) error {
+	if err := ch.storage.RemoveChannelUser(ch.channelId, username); err != nil {
+		return fmt.Errorf("cannot remove user %s from channel %d: %w", username, ch.channelId, err)
+	}
+
+	delete(ch.users, username)
+	return nil
+}
+
+// isBanned checks whether the user has a ban in the channel, which hasn't expired yet.
+func (ch *ServerChannel) isBanned(username string) bool {
+	ban, ok := ch.bans[username]
+	return ok && (ban.ExpiresAt.IsZero() || time.Now().Before(ban.ExpiresAt))
+}
+
+// activeBans returns the bans of the channel, which haven't expired yet, sorted by usernames.
+func (ch *ServerChannel) activeBans() ([]*pb.Ban, error) {
+	bans := make([]*pb.Ban, 0, len(ch.bans))
+	for username, ban := range ch.bans {
+		if !ch.isBanned(username) {
+			continue
+		}
+		pbBan, err := getPBBan(ban)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, pbBan)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Username < bans[j].Username
+	})
+	return bans, nil
+}
+
+// roleOf returns the role of the user in the channel. Users, who are not
+// members of a public channel, are treated as its subscribers, unless they are banned.
+func (ch *ServerChannel) roleOf(username string) Role {
+	if ch.isBanned(username) {
+		return UnknownRole
+	}
+	if user, ok := ch.users[username]; ok {
+		return user.role
+	}
+	if ch.isPublic {
+		return SubscriberRole
+	}
+	return UnknownRole
+}
+
+// hasPermission checks whether the user's role in the channel has the permission.
+func (ch *ServerChannel) hasPermission(username string, perm Permission) bool {
+	role := ch.roleOf(username)
+	for _, r := range ch.rolesWithPermission[perm] {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize returns PermissionDenied error if the user doesn't have the permission in the channel.
+func (ch *ServerChannel) authorize(username string, perm Permission) error {
+	if !ch.hasPermission(username, perm) {
+		return status.Errorf(codes.PermissionDenied, "user %s doesn't have permission %v in channel %d", username, AccordToPBPermissions[perm], ch.channelId)
+	}
+	return nil
+}
+
+// authorizeRequest checks whether the user is allowed to make the stream request.
+func (ch *ServerChannel) authorizeRequest(username string, req *pb.ChannelStreamRequest) error {
+	perm, err := channelStreamRequestPermission(req)
+	if err != nil {
+		return err
+	}
+	if err := ch.authorize(username, perm); err != nil {
+		return err
+	}
+	if err := ch.authorizeUserMessage(username, req.GetUserMsg()); err != nil {
+		return err
+	}
+
+	// Nobody can grant permissions, which they don't have themselves.
+	configMsg := req.GetConfigMsg()
+	switch configMsg.GetMsg().(type) {
+	case *pb.ChannelConfigMessage_DefineRoleMsg:
+		for _, p := range configMsg.GetDefineRoleMsg().GetRole().GetPermissions() {
+			if perm := PBToAccordPermissions[p]; perm != UnknownPermission && !ch.hasPermission(username, perm) {
+				return ch.grantError(username, perm)
+			}
+		}
+	case *pb.ChannelConfigMessage_RoleMsg:
+		roleMsg := configMsg.GetRoleMsg()
+		if err := ch.authorizeGrant(username, getRoleFromPB(roleMsg.GetRole(), roleMsg.GetCustomRoleId())); err != nil {
+			return err
+		}
+	}
+
+	// Only superadmins can manage custom roles and make or unmake other superadmins.
+	// Otherwise, anyone who can assign roles could grant themselves any permission.
+	if ch.roleOf(username) == SuperadminRole {
+		return nil
+	}
+	switch configMsg.GetMsg().(type) {
+	case *pb.ChannelConfigMessage_DefineRoleMsg, *pb.ChannelConfigMessage_RemoveRoleMsg:
+		return status.Errorf(codes.PermissionDenied, "only superadmins can manage roles of channel %d", ch.channelId)
+	case *pb.ChannelConfigMessage_RoleMsg:
+		roleMsg := configMsg.GetRoleMsg()
+		role := getRoleFromPB(roleMsg.GetRole(), roleMsg.GetCustomRoleId())
+		if role == SuperadminRole || ch.roleOf(roleMsg.GetUsername()) == SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can change superadmins of channel %d", ch.channelId)
+		}
+	case *pb.ChannelConfigMessage_KickMsg:
+		if ch.roleOf(configMsg.GetKickMsg().GetUsername()) == SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can kick superadmins of channel %d", ch.channelId)
+		}
+	case *pb.ChannelConfigMessage_BanMsg:
+		if ch.roleOf(configMsg.GetBanMsg().GetBan().GetUsername()) == SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can ban superadmins of channel %d", ch.channelId)
+		}
+	}
+	return nil
+}
+
+// authorizeUserMessage checks that only authors edit their messages, and that
+// messages of others are deleted only by users with DeletePermission.
+func (ch *ServerChannel) authorizeUserMessage(username string, m *pb.ChannelStreamRequest_UserMessage) error {
+	switch m.GetUserMsg().(type) {
+	case *pb.ChannelStreamRequest_UserMessage_EditUserMsg:
+		msg, err := ch.getExistingMessage(m.GetEditUserMsg().GetMessageId())
+		if err != nil {
+			// missing messages are rejected when the request is processed
+			return nil
+		}
+		if msg.Sender != username {
+			return status.Errorf(codes.PermissionDenied, "only the author can edit message %d", msg.MessageID)
+		}
+	case *pb.ChannelStreamRequest_UserMessage_DeleteUserMsg:
+		msg, err := ch.getExistingMessage(m.GetDeleteUserMsg().GetMessageId())
+		if err != nil {
+			return nil
+		}
+		if msg.Sender != username {
+			return ch.authorize(username, DeletePermission)
+		}
+	}
+	return nil
+}
+
+// roleExists checks whether the role can be assigned to users of the channel.
+func (ch *ServerChannel) roleExists(role Role) bool {
+	if role.IsCustom() {
+		_, ok := ch.customRoles[role]
+		return ok
+	}
+	return role > UnknownRole && role <= SuperadminRole
+}
+
+// authorizeGrant returns PermissionDenied error if the role has permissions,
+// which the user doesn't have in the channel, so that users, who can assign
+// roles, cannot give others or themselves more power than they have.
+func (ch *ServerChannel) authorizeGrant(username string, role Role) error {
+	for perm := ReadPermission; perm <= RemoveChannelPermission; perm++ {
+		for _, r := range ch.rolesWithPermission[perm] {
+			if r == role && !ch.hasPermission(username, perm) {
+				return ch.grantError(username, perm)
+			}
+		}
+	}
+	return nil
+}
+
+func (ch *ServerChannel) grantError(username string, perm Permission) error {
+	return status.Errorf(codes.PermissionDenied, "user %s cannot grant permission %v, which the user doesn't have in channel %d", username, AccordToPBPermissions[perm], ch.channelId)
+}
+
+// nextCustomRole returns the role, which will be assigned to the next defined custom role.
+func (ch *ServerChannel) nextCustomRole() Role {
+	next := FirstCustomRole
+	for role := range ch.customRoles {
+		if role >= next {
+			next = role + 1
+		}
+	}
+	return next
+}
+
+// roleDefinitions returns built-in and custom roles of the channel with their
+// permissions, sorted by roles.
+func (ch *ServerChannel) roleDefinitions() []*pb.RoleDefinition {
+	names := make(map[Role]string, len(ch.customRoles)+int(SuperadminRole))
+	for role := SubscriberRole; role <= SuperadminRole; role++ {
+		names[role] = AccordToPBRoles[role].String()
+	}
+	for role, name := range ch.customRoles {
+		names[role] = name
+	}
+
+	defs := make([]*pb.RoleDefinition, 0, len(names))
+	for role, name := range names {
+		def := &pb.RoleDefinition{
+			RoleId: int32(role),
+			Name:   name,
+		}
+		for perm := ReadPermission; perm <= RemoveChannelPermission; perm++ {
+			for _, r := range ch.rolesWithPermission[perm] {
+				if r == role {
+					def.Permissions = append(def.Permissions, AccordToPBPermissions[perm])
+					break
+				}
+			}
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].RoleId < defs[j].RoleId
+	})
+	return defs
+}
+
+// withoutRole returns a copy of roles without the role.
+func withoutRole(roles []Role, role Role) []Role {
+	res := make([]Role, 0, len(roles))
+	for _, r := range roles {
+		if r != role {
+			res = append(res, r)
+		}
+	}
+	return res
 }
 
 // Listen listens for the incoming messages.
 func (ch *ServerChannel) listen() {
+	defer close(ch.stopped)
+	// typing signals expire on their own, so they are pruned periodically
+	typingTicker := time.NewTicker(typingTimeout)
+	defer typingTicker.Stop()
 	for {
 		select {
-		case req := <-ch.msgc:
-			res, err := ch.processChannelStreamRequest(req)
-			if err == nil {
-				ch.broadcast(res)
-			} else {
-				log.Printf("Failed to process request %v: %v\n", req, err)
+		case <-ch.ctx.Done():
+			return
+		case now := <-typingTicker.C:
+			ch.pruneTyping(now)
+		case f := <-ch.funcc:
+			f()
+		case r := <-ch.msgc:
+			r.errc <- ch.handleRequest(r)
+		}
+	}
+}
+
+// handleRequest authorizes and processes the request, and broadcasts its
+// result. The returned error is reported only to the user, who has made the
+// request.
+func (ch *ServerChannel) handleRequest(r *channelStreamRequest) error {
+	err := ch.authorizeRequest(r.username, r.req)
+	if err == nil {
+		err = validateRequest(r.req)
+	}
+	if err != nil {
+		log.Printf("Rejected request %v from %s: %v\n", r.req, r.username, err)
+		return err
+	}
+
+	// ephemeral events are neither stored nor sent back to their senders
+	if ephemeralMsg := r.req.GetEphemeralMsg(); ephemeralMsg != nil {
+		res, err := ch.processEphemeralMessage(r.username, ephemeralMsg)
+		if err != nil {
+			log.Printf("Failed to process request %v: %v\n", r.req, err)
+			return err
+		}
+		if res != nil {
+			ch.broadcastExcept(res, r.username)
+		}
+		return nil
+	}
+
+	res, err := ch.processChannelStreamRequest(r.username, r.req)
+	if err != nil {
+		log.Printf("Failed to process request %v: %v\n", r.req, err)
+		return err
+	}
+	// like GetMessages, read receipts are shown only to members
+	if res.GetUserMsg().GetReadMsg() != nil {
+		ch.broadcastToMembers(res)
+	} else {
+		ch.broadcast(res)
+	}
+
+	// kicked and banned users are notified first, and then their streams are closed
+	configMsg := res.GetConfigMsg()
+	switch configMsg.GetMsg().(type) {
+	case *pb.ChannelConfigMessage_KickMsg:
+		username := configMsg.GetKickMsg().GetUsername()
+		ch.closeStream(username, status.Errorf(codes.PermissionDenied, "user %s has been kicked from channel %d", username, ch.channelId))
+	case *pb.ChannelConfigMessage_BanMsg:
+		username := configMsg.GetBanMsg().GetBan().GetUsername()
+		ch.closeStream(username, status.Errorf(codes.PermissionDenied, "user %s has been banned in channel %d", username, ch.channelId))
+	}
+	return nil
+}
+
+// validateRequest returns InvalidArgument error if the request is malformed,
+// e.g. the ban, which it adds, has already expired.
+func validateRequest(req *pb.ChannelStreamRequest) error {
+	if ban := req.GetConfigMsg().GetBanMsg().GetBan(); ban != nil {
+		if expiresAt := ban.GetExpiresAt(); expiresAt != nil {
+			if err := expiresAt.CheckValid(); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid expiration time: %v", err)
+			}
+			if !expiresAt.AsTime().After(time.Now()) {
+				return status.Errorf(codes.InvalidArgument, "expiration time has to be in the future")
 			}
 		}
 	}
+	return nil
+}
+
+// do runs f in the listen goroutine of the channel, so that f can access the
+// state of the channel, and returns the error of f. If the channel has been
+// removed, f is not run. f must not wait for anything, which may be waiting
+// for the channel itself, e.g. the presence registry.
+func (ch *ServerChannel) do(f func() error) error {
+	errc := make(chan error, 1)
+	select {
+	case ch.funcc <- func() { errc <- f() }:
+	case <-ch.ctx.Done():
+		return ch.removedError()
+	}
+	return <-errc
+}
+
+// remove stops the channel, which has been removed by the user, and closes
+// all streams with it after sending them the final event. It returns when
+// listen has returned, so the channel isn't changed afterwards.
+func (ch *ServerChannel) remove(removedBy string) {
+	ch.cancel()
+	<-ch.stopped
+
+	ch.broadcast(&pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_ChannelRemovedMsg{
+			ChannelRemovedMsg: &pb.ChannelStreamResponse_ChannelRemovedMessage{
+				RemovedBy: removedBy,
+			},
+		},
+	})
+	ch.streamsMutex.Lock()
+	defer ch.streamsMutex.Unlock()
+	for username, stream := range ch.usersToStreams {
+		delete(ch.usersToStreams, username)
+		select {
+		case stream.closec <- ch.removedError():
+		default:
+		}
+	}
+	close(ch.removed)
+}
+
+// removedError is the error, with which streams with the removed channel terminate.
+func (ch *ServerChannel) removedError() error {
+	return status.Errorf(codes.NotFound, "channel %d has been removed", ch.channelId)
+}
+
+// addStream registers the stream of the user for broadcasting. The previous
+// stream of the user, if any, is replaced.
+func (ch *ServerChannel) addStream(username string, stream *channelStream) {
+	ch.streamsMutex.Lock()
+	defer ch.streamsMutex.Unlock()
+	ch.usersToStreams[username] = stream
+}
+
+// removeStream unregisters the stream of the user unless it has already been
+// replaced, and forgets the typing signal of the user, who has disconnected.
+// It must not be called by listen.
+func (ch *ServerChannel) removeStream(username string, stream *channelStream) {
+	ch.streamsMutex.Lock()
+	current, ok := ch.usersToStreams[username]
+	if current == stream {
+		delete(ch.usersToStreams, username)
+	}
+	ch.streamsMutex.Unlock()
+	if ok && current != stream {
+		// the user is still streaming with the newer stream
+		return
+	}
+	// the error only means that the channel has been removed
+	_ = ch.do(func() error {
+		delete(ch.typing, username)
+		return nil
+	})
+}
+
+// closeStream unregisters the stream of the user and lets it terminate with the error.
+func (ch *ServerChannel) closeStream(username string, err error) {
+	ch.streamsMutex.Lock()
+	defer ch.streamsMutex.Unlock()
+	stream, ok := ch.usersToStreams[username]
+	if !ok {
+		return
+	}
+	delete(ch.usersToStreams, username)
+	select {
+	case stream.closec <- err:
+	default:
+	}
 }
 
 // Broadcast sends message to all users in the chat.
 func (ch *ServerChannel) broadcast(response *pb.ChannelStreamResponse) {
+	ch.broadcastExcept(response, "")
+}
+
+// broadcastExcept sends message to all users in the chat except the user.
+func (ch *ServerChannel) broadcastExcept(response *pb.ChannelStreamResponse, except string) {
+	ch.broadcastIf(response, func(username string) bool {
+		return username != except
+	})
+}
+
+// broadcastToMembers sends message to members of the chat, but not to its
+// subscribers. It has to be called from the listen goroutine.
+func (ch *ServerChannel) broadcastToMembers(response *pb.ChannelStreamResponse) {
+	ch.broadcastIf(response, func(username string) bool {
+		_, ok := ch.users[username]
+		return ok
+	})
+}
+
+// broadcastIf sends message to the users in the chat, for whom include returns true.
+func (ch *ServerChannel) broadcastIf(response *pb.ChannelStreamResponse, include func(username string) bool) {
+	ch.streamsMutex.Lock()
+	defer ch.streamsMutex.Unlock()
+
 	// only broadcast to clients, who are currently streaming with the server
 	for username, stream := range ch.usersToStreams {
+		if !include(username) {
+			continue
+		}
 		// TODO: also check for permissions to read (i.e. receive broadcast)
-		if err := stream.Send(response); err != nil {
-			log.Printf("Could not send message to %s in channel %v\n", username, ch.name)
+		if !stream.enqueue(response, ch.queues) {
+			log.Printf("Disconnecting %s from channel %v, since the client cannot keep up\n", username, ch.name)
+			delete(ch.usersToStreams, username)
+			select {
+			case stream.closec <- errSlowConsumer:
+			default:
+			}
 		}
 	}
 }
 
-func (ch *ServerChannel) processChannelStreamRequest(m *pb.ChannelStreamRequest) (*pb.ChannelStreamResponse, error) {
+// lastMessageID returns the Id of the newest message of the channel, or zero
+// if there are no messages.
+func (ch *ServerChannel) lastMessageID() (uint64, error) {
+	msgs, err := ch.storage.Messages(ch.channelId, 0, 0, 1, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+	return msgs[0].MessageID, nil
+}
+
+// processChannelStreamRequest applies the request of the user to the channel
+// and returns the response, which has to be broadcasted.
+func (ch *ServerChannel) processChannelStreamRequest(username string, m *pb.ChannelStreamRequest) (*pb.ChannelStreamResponse, error) {
 	switch m.GetMsg().(type) {
 	case *pb.ChannelStreamRequest_UserMsg:
-		res, err := ch.processChannelStreamRequestUserMessage(m.GetUserMsg())
+		res, err := ch.processChannelStreamRequestUserMessage(username, m.GetUserMsg())
 		if err != nil {
-			return &pb.ChannelStreamResponse{
-				Msg: &pb.ChannelStreamResponse_UserMsg{
-					UserMsg: res,
-				},
-			}, nil
+			return nil, err
 		}
-		return nil, err
+		return &pb.ChannelStreamResponse{
+			Msg: &pb.ChannelStreamResponse_UserMsg{
+				UserMsg: res,
+			},
+		}, nil
 	case *pb.ChannelStreamRequest_ConfigMsg:
-		res, err := ch.processChannelStreamRequestConfigMessage(m.GetConfigMsg())
+		res, err := ch.processChannelStreamRequestConfigMessage(username, m.GetConfigMsg())
 		if err != nil {
-			return &pb.ChannelStreamResponse{
-				Msg: &pb.ChannelStreamResponse_ConfigMsg{
-					ConfigMsg: res,
-				},
-			}, nil
+			return nil, err
 		}
-		return nil, err
+		return &pb.ChannelStreamResponse{
+			Msg: &pb.ChannelStreamResponse_ConfigMsg{
+				ConfigMsg: res,
+			},
+		}, nil
 	}
 	return nil, fmt.Errorf("Invalid request type: %v", reflect.TypeOf(m.GetMsg()))
 }
 
-// TODO: Totally rewrite this function when we add persistent layer.
-func (ch *ServerChannel) processChannelStreamRequestUserMessage(m *pb.ChannelStreamRequest_UserMessage) (*pb.ChannelStreamResponse_UserMessage, error) {
+// processEphemeralMessage returns the event, which has to be sent to other
+// users, or nil if it has been throttled.
+func (ch *ServerChannel) processEphemeralMessage(username string, m *pb.EphemeralMessage) (*pb.ChannelStreamResponse, error) {
+	var res *pb.EphemeralMessage
+	switch m.GetMsg().(type) {
+	case *pb.EphemeralMessage_TypingMsg:
+		typingMsg, err := ch.processTypingMessage(username, m.GetTypingMsg())
+		if err != nil || typingMsg == nil {
+			return nil, err
+		}
+		res = &pb.EphemeralMessage{
+			Username: username,
+			Msg: &pb.EphemeralMessage_TypingMsg{
+				TypingMsg: typingMsg,
+			},
+		}
+	default:
+		return nil, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(m.GetMsg()))
+	}
+	return &pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_EphemeralMsg{
+			EphemeralMsg: res,
+		},
+	}, nil
+}
+
+// pruneTyping forgets typing signals, which have expired by now.
+func (ch *ServerChannel) pruneTyping(now time.Time) {
+	for username, expiresAt := range ch.typing {
+		if !now.Before(expiresAt) {
+			delete(ch.typing, username)
+		}
+	}
+}
+
+// processTypingMessage throttles typing signals of the user. Signals, which
+// come less than typingThrottle after the last sent one, are dropped, as well
+// as stops of typing, which has already expired.
+func (ch *ServerChannel) processTypingMessage(username string, m *pb.EphemeralMessage_TypingEphemeralMessage) (*pb.EphemeralMessage_TypingEphemeralMessage, error) {
+	now := time.Now()
+	expiresAt, ok := ch.typing[username]
+	isTyping := ok && now.Before(expiresAt)
+	if m.GetStopped() {
+		delete(ch.typing, username)
+		if !isTyping {
+			return nil, nil
+		}
+		expiresAt = now
+	} else {
+		if isTyping && now.Before(expiresAt.Add(typingThrottle-typingTimeout)) {
+			return nil, nil
+		}
+		expiresAt = now.Add(typingTimeout)
+		ch.typing[username] = expiresAt
+	}
+	pbExpiresAt, err := ptypes.TimestampProto(expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EphemeralMessage_TypingEphemeralMessage{
+		Stopped:   m.GetStopped(),
+		ExpiresAt: pbExpiresAt,
+	}, nil
+}
+
+// getNewAndUpdateUserMessageResponse creates the response, which is broadcasted
+// when the message is either created or edited.
+func getNewAndUpdateUserMessageResponse(msg *MessageRecord) (*pb.ChannelStreamResponse_UserMessage, error) {
+	timestamp, err := ptypes.TimestampProto(msg.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	lastReplyAt, err := getPBTimestamp(msg.LastReplyAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChannelStreamResponse_UserMessage{
+		MessageId:    msg.MessageID,
+		Sender:       msg.Sender,
+		ReplyTo:      msg.ReplyTo,
+		ThreadRootId: msg.ThreadRootID,
+		UserMsg: &pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg{
+			NewAndUpdateUserMsg: &pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage{
+				Timestamp:   timestamp,
+				Content:     msg.Content,
+				ReplyCount:  msg.ReplyCount,
+				LastReplyAt: lastReplyAt,
+			},
+		},
+	}, nil
+}
+
+// lastReplyAt returns the time of the last reply in the thread, which hasn't
+// been deleted, or zero time if there is no such reply.
+func (ch *ServerChannel) lastReplyAt(threadRootID uint64) (time.Time, error) {
+	replies, err := ch.storage.ThreadMessages(ch.channelId, threadRootID, 0, 0, 1, true)
+	if err != nil || len(replies) == 0 {
+		return time.Time{}, err
+	}
+	return replies[0].Timestamp, nil
+}
+
+// getExistingMessage returns the message from the channel's log unless it has been deleted.
+func (ch *ServerChannel) getExistingMessage(messageID uint64) (*MessageRecord, error) {
+	msg, err := ch.storage.Message(ch.channelId, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Deleted {
+		return nil, fmt.Errorf("message with id %d has been deleted", messageID)
+	}
+	return msg, nil
+}
+
+// react adds or removes the reaction of the user with the emoji to the message.
+// All reactions to the message are broadcasted, even if nothing has changed.
+func (ch *ServerChannel) react(username string, messageID uint64, emoji string, add bool) (*pb.ChannelStreamResponse_UserMessage, error) {
+	if err := validateEmoji(emoji); err != nil {
+		return nil, err
+	}
+	msg, err := ch.getExistingMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := setReaction(msg, username, emoji, add)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		if err := ch.storage.UpdateMessage(ch.channelId, msg); err != nil {
+			return nil, err
+		}
+	}
+	return &pb.ChannelStreamResponse_UserMessage{
+		MessageId:    msg.MessageID,
+		Sender:       msg.Sender,
+		ReplyTo:      msg.ReplyTo,
+		ThreadRootId: msg.ThreadRootID,
+		UserMsg: &pb.ChannelStreamResponse_UserMessage_ReactionsMsg{
+			ReactionsMsg: &pb.ChannelStreamResponse_UserMessage_ReactionsUserMessage{
+				Reactions: getPBReactions(msg.Reactions),
+			},
+		},
+	}, nil
+}
+
+// markRead moves the last message read by the user forward to the message.
+// The response is broadcasted even if the user has already read the message.
+func (ch *ServerChannel) markRead(username string, messageID uint64) (*pb.ChannelStreamResponse_UserMessage, error) {
+	user := ch.users[username]
+	if user == nil {
+		return nil, fmt.Errorf("user '%s' is not in the channel %s", username, ch.name)
+	}
+	// deleted messages can be read as well, since they stay in the log
+	if _, err := ch.storage.Message(ch.channelId, messageID); err != nil {
+		return nil, err
+	}
+	if messageID > user.lastReadID {
+		updated := &channelUser{
+			user:       user.user,
+			role:       user.role,
+			lastReadID: messageID,
+		}
+		if err := ch.addUser(updated); err != nil {
+			return nil, err
+		}
+		user = updated
+	}
+	return &pb.ChannelStreamResponse_UserMessage{
+		MessageId: user.lastReadID,
+		UserMsg: &pb.ChannelStreamResponse_UserMessage_ReadMsg{
+			ReadMsg: &pb.ChannelStreamResponse_UserMessage_ReadUserMessage{
+				Username: username,
+			},
+		},
+	}, nil
+}
+
+// seenBy returns members of the channel other than the sender, who have read
+// the message, sorted by their usernames.
+func (ch *ServerChannel) seenBy(msg *MessageRecord) []string {
+	var usernames []string
+	for username, user := range ch.users {
+		if username != msg.Sender && user.lastReadID >= msg.MessageID {
+			usernames = append(usernames, username)
+		}
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+// mentions reports whether the content mentions the user as @username.
+func mentions(content, username string) bool {
+	mention := "@" + username
+	for {
+		i := strings.Index(content, mention)
+		if i < 0 {
+			return false
+		}
+		content = content[i+len(mention):]
+		next, _ := utf8.DecodeRuneInString(content)
+		if content == "" || !(unicode.IsLetter(next) || unicode.IsDigit(next) || next == '_') {
+			return true
+		}
+	}
+}
+
+// setReaction adds or removes the user from the reaction with the emoji and
+// reports whether the reactions of the message have changed.
+func setReaction(msg *MessageRecord, username, emoji string, add bool) (bool, error) {
+	for i := range msg.Reactions {
+		reaction := &msg.Reactions[i]
+		if reaction.Emoji != emoji {
+			continue
+		}
+		for j, name := range reaction.Usernames {
+			if name != username {
+				continue
+			}
+			if add {
+				return false, nil
+			}
+			reaction.Usernames = append(reaction.Usernames[:j], reaction.Usernames[j+1:]...)
+			if len(reaction.Usernames) == 0 {
+				msg.Reactions = append(msg.Reactions[:i], msg.Reactions[i+1:]...)
+			}
+			return true, nil
+		}
+		if !add {
+			return false, nil
+		}
+		reaction.Usernames = append(reaction.Usernames, username)
+		return true, nil
+	}
+	if !add {
+		return false, nil
+	}
+	if len(msg.Reactions) >= maxReactionsPerMessage {
+		return false, fmt.Errorf("message with id %d has too many reactions", msg.MessageID)
+	}
+	msg.Reactions = append(msg.Reactions, ReactionRecord{
+		Emoji:     emoji,
+		Usernames: []string{username},
+	})
+	return true, nil
+}
+
+// validateEmoji checks that the emoji is a short valid UTF-8 string.
+func validateEmoji(emoji string) error {
+	if emoji == "" {
+		return fmt.Errorf("emoji is empty")
+	}
+	if len(emoji) > maxEmojiLength {
+		return fmt.Errorf("emoji is longer than %d bytes", maxEmojiLength)
+	}
+	if !utf8.ValidString(emoji) {
+		return fmt.Errorf("emoji is not a valid UTF-8 string")
+	}
+	return nil
+}
+
+// getThreadRoot returns the first message of the thread, which the reply to
+// the message is added to. Deleted messages cannot be replied to, but their
+// threads can still grow.
+func (ch *ServerChannel) getThreadRoot(replyTo uint64) (*MessageRecord, error) {
+	msg, err := ch.getExistingMessage(replyTo)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ThreadRootID == 0 {
+		return msg, nil
+	}
+	return ch.storage.Message(ch.channelId, msg.ThreadRootID)
+}
+
+// processChannelStreamRequestUserMessage applies the user message, which has
+// been authorized, so the author of edited or deleted messages is not checked.
+func (ch *ServerChannel) processChannelStreamRequestUserMessage(username string, m *pb.ChannelStreamRequest_UserMessage) (*pb.ChannelStreamResponse_UserMessage, error) {
 	switch m.GetUserMsg().(type) {
 	case *pb.ChannelStreamRequest_UserMessage_NewUserMsg:
-		timestamp, _ := ptypes.TimestampProto(time.Now())
+		newMsg := m.GetNewUserMsg()
+		msg := &MessageRecord{
+			Timestamp: time.Now(),
+			Sender:    username,
+			Content:   newMsg.GetContent(),
+		}
+		var root *MessageRecord
+		if newMsg.GetReplyTo() != 0 {
+			var err error
+			if root, err = ch.getThreadRoot(newMsg.GetReplyTo()); err != nil {
+				return nil, err
+			}
+			msg.ReplyTo = newMsg.GetReplyTo()
+			msg.ThreadRootID = root.MessageID
+		}
+		messageID, err := ch.storage.AppendMessage(ch.channelId, msg)
+		if err != nil {
+			return nil, err
+		}
+		msg.MessageID = messageID
+		// the user is expected to have stopped typing after sending the message
+		delete(ch.typing, username)
+		if root != nil {
+			root.ReplyCount++
+			root.LastReplyAt = msg.Timestamp
+			if err := ch.storage.UpdateMessage(ch.channelId, root); err != nil {
+				return nil, err
+			}
+		}
+		return getNewAndUpdateUserMessageResponse(msg)
+	case *pb.ChannelStreamRequest_UserMessage_EditUserMsg:
+		editMsg := m.GetEditUserMsg()
+		msg, err := ch.getExistingMessage(editMsg.GetMessageId())
+		if err != nil {
+			return nil, err
+		}
+		msg.Content = editMsg.GetContent()
+		if err := ch.storage.UpdateMessage(ch.channelId, msg); err != nil {
+			return nil, err
+		}
+		return getNewAndUpdateUserMessageResponse(msg)
+	case *pb.ChannelStreamRequest_UserMessage_DeleteUserMsg:
+		msg, err := ch.getExistingMessage(m.GetDeleteUserMsg().GetMessageId())
+		if err != nil {
+			return nil, err
+		}
+		msg.Content = ""
+		msg.Deleted = true
+		msg.Reactions = nil
+		if err := ch.storage.UpdateMessage(ch.channelId, msg); err != nil {
+			return nil, err
+		}
+		if msg.ThreadRootID != 0 {
+			root, err := ch.storage.Message(ch.channelId, msg.ThreadRootID)
+			if err != nil {
+				return nil, err
+			}
+			if root.ReplyCount > 0 {
+				root.ReplyCount--
+			}
+			// the deleted reply may have been the last one
+			if root.LastReplyAt, err = ch.lastReplyAt(root.MessageID); err != nil {
+				return nil, err
+			}
+			if err := ch.storage.UpdateMessage(ch.channelId, root); err != nil {
+				return nil, err
+			}
+		}
 		return &pb.ChannelStreamResponse_UserMessage{
-			MessageId: rand.Uint64(),
-			UserMsg: &pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg{
-				NewAndUpdateUserMsg: &pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage{
-					Timestamp: timestamp,
-					Content:   m.GetNewUserMsg().GetContent(),
-				},
+			MessageId:    msg.MessageID,
+			Sender:       msg.Sender,
+			ReplyTo:      msg.ReplyTo,
+			ThreadRootId: msg.ThreadRootID,
+			UserMsg: &pb.ChannelStreamResponse_UserMessage_DeleteUserMsg{
+				DeleteUserMsg: &pb.ChannelStreamResponse_UserMessage_DeleteUserMessage{},
 			},
 		}, nil
-	case *pb.ChannelStreamRequest_UserMessage_EditUserMsg:
-		return nil, fmt.Errorf("persistent layer is not implemented yet, thus, message editing is not implemented too")
-	case *pb.ChannelStreamRequest_UserMessage_DeleteUserMsg:
-		return nil, fmt.Errorf("persistent layer is not implemented yet, thus, message deletion is not implemented too")
+	case *pb.ChannelStreamRequest_UserMessage_AddReactionMsg:
+		reactionMsg := m.GetAddReactionMsg()
+		return ch.react(username, reactionMsg.GetMessageId(), reactionMsg.GetEmoji(), true)
+	case *pb.ChannelStreamRequest_UserMessage_RemoveReactionMsg:
+		reactionMsg := m.GetRemoveReactionMsg()
+		return ch.react(username, reactionMsg.GetMessageId(), reactionMsg.GetEmoji(), false)
+	case *pb.ChannelStreamRequest_UserMessage_MarkReadMsg:
+		return ch.markRead(username, m.GetMarkReadMsg().GetMessageId())
 	}
 	return nil, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(m.GetUserMsg()))
 }
 
-func (ch *ServerChannel) processChannelStreamRequestConfigMessage(m *pb.ChannelConfigMessage) (*pb.ChannelConfigMessage, error) {
+func (ch *ServerChannel) processChannelStreamRequestConfigMessage(username string, m *pb.ChannelConfigMessage) (*pb.ChannelConfigMessage, error) {
 	switch m.GetMsg().(type) {
 	case *pb.ChannelConfigMessage_NameMsg:
 		nameMsg := m.GetNameMsg()
-		ch.name = nameMsg.GetNewChannelName()
+		record := ch.record()
+		record.Name = nameMsg.GetNewChannelName()
+		if err := ch.storage.SaveChannel(record); err != nil {
+			return nil, err
+		}
+		ch.name = record.Name
+		if ch.serverStreams != nil {
+			ch.serverStreams.broadcastRenameChannel(ch)
+		}
 		return m, nil
 	case *pb.ChannelConfigMessage_RoleMsg:
 		roleMsg := m.GetRoleMsg()
 		user := ch.users[roleMsg.GetUsername()]
-		if user != nil {
-			user.role = PBToAccordRoles[roleMsg.GetRole()]
+		if user == nil {
+			return nil, fmt.Errorf("user '%s' is not in the channel %s", roleMsg.GetUsername(), ch.name)
+		}
+		role := getRoleFromPB(roleMsg.GetRole(), roleMsg.GetCustomRoleId())
+		if !ch.roleExists(role) {
+			return nil, fmt.Errorf("role %d doesn't exist in the channel %s", role, ch.name)
 		}
-		return nil, fmt.Errorf("user '%s' is not in the channel %s", roleMsg.GetUsername(), ch.name)
+		updated := &channelUser{
+			user:       user.user,
+			role:       role,
+			lastReadID: user.lastReadID,
+		}
+		if err := ch.addUser(updated); err != nil {
+			return nil, err
+		}
+		return m, nil
 	case *pb.ChannelConfigMessage_PinMsg:
 		pinMsg := m.GetPinMsg()
-		ch.pinnedMsgId = pinMsg.GetMessageId()
+		record := ch.record()
+		record.PinnedMsgID = pinMsg.GetMessageId()
+		if err := ch.storage.SaveChannel(record); err != nil {
+			return nil, err
+		}
+		ch.pinnedMsgId = record.PinnedMsgID
+		return m, nil
+	case *pb.ChannelConfigMessage_DefineRoleMsg:
+		def := m.GetDefineRoleMsg().GetRole()
+		role := Role(def.GetRoleId())
+		if role == UnknownRole {
+			role = ch.nextCustomRole()
+		} else if _, ok := ch.customRoles[role]; !ok {
+			return nil, fmt.Errorf("custom role %d doesn't exist in the channel %s", role, ch.name)
+		}
+		if def.GetName() == "" {
+			return nil, fmt.Errorf("name of the role cannot be empty")
+		}
+
+		record := ch.record()
+		record.CustomRoles[role] = def.GetName()
+		for perm, roles := range record.RolesWithPermission {
+			record.RolesWithPermission[perm] = withoutRole(roles, role)
+		}
+		for _, p := range def.GetPermissions() {
+			if perm := PBToAccordPermissions[p]; perm != UnknownPermission {
+				record.RolesWithPermission[perm] = append(withoutRole(record.RolesWithPermission[perm], role), role)
+			}
+		}
+		if err := ch.storage.SaveChannel(record); err != nil {
+			return nil, err
+		}
+		ch.customRoles = record.CustomRoles
+		ch.rolesWithPermission = record.RolesWithPermission
+
+		return &pb.ChannelConfigMessage{
+			Msg: &pb.ChannelConfigMessage_DefineRoleMsg{
+				DefineRoleMsg: &pb.ChannelConfigMessage_DefineRoleChannelConfigMessage{
+					Role: &pb.RoleDefinition{
+						RoleId:      int32(role),
+						Name:        def.GetName(),
+						Permissions: def.GetPermissions(),
+					},
+				},
+			},
+		}, nil
+	case *pb.ChannelConfigMessage_RemoveRoleMsg:
+		role := Role(m.GetRemoveRoleMsg().GetRoleId())
+		if _, ok := ch.customRoles[role]; !ok {
+			return nil, fmt.Errorf("custom role %d doesn't exist in the channel %s", role, ch.name)
+		}
+
+		record := ch.record()
+		delete(record.CustomRoles, role)
+		for perm, roles := range record.RolesWithPermission {
+			record.RolesWithPermission[perm] = withoutRole(roles, role)
+		}
+		if err := ch.storage.SaveChannel(record); err != nil {
+			return nil, err
+		}
+		ch.customRoles = record.CustomRoles
+		ch.rolesWithPermission = record.RolesWithPermission
+
+		for _, user := range ch.users {
+			if user.role != role {
+				continue
+			}
+			member := &channelUser{
+				user:       user.user,
+				role:       MemberRole,
+				lastReadID: user.lastReadID,
+			}
+			if err := ch.addUser(member); err != nil {
+				log.Print(err)
+			}
+		}
+		return m, nil
+	case *pb.ChannelConfigMessage_KickMsg:
+		target := m.GetKickMsg().GetUsername()
+		if _, ok := ch.users[target]; !ok {
+			return nil, fmt.Errorf("user '%s' is not in the channel %s", target, ch.name)
+		}
+		if err := ch.removeUser(target); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case *pb.ChannelConfigMessage_BanMsg:
+		ban, err := getBanRecord(m.GetBanMsg().GetBan())
+		if err != nil {
+			return nil, err
+		}
+		if ban.Username == "" {
+			return nil, fmt.Errorf("username of the banned user cannot be empty")
+		}
+		ban.BannedBy = username
+		ban.BannedAt = time.Now()
+		if err := ch.storage.SaveBan(ch.channelId, ban); err != nil {
+			return nil, err
+		}
+		ch.bans[ban.Username] = ban
+		if _, ok := ch.users[ban.Username]; ok {
+			if err := ch.removeUser(ban.Username); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := ch.pending[ban.Username]; ok {
+			if err := ch.removeMembershipRequest(ban.Username); err != nil {
+				return nil, err
+			}
+		}
+
+		pbBan, err := getPBBan(ban)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ChannelConfigMessage{
+			Msg: &pb.ChannelConfigMessage_BanMsg{
+				BanMsg: &pb.ChannelConfigMessage_BanChannelConfigMessage{
+					Ban: pbBan,
+				},
+			},
+		}, nil
+	case *pb.ChannelConfigMessage_UnbanMsg:
+		target := m.GetUnbanMsg().GetUsername()
+		if _, ok := ch.bans[target]; !ok {
+			return nil, fmt.Errorf("user '%s' is not banned in the channel %s", target, ch.name)
+		}
+		if err := ch.storage.RemoveBan(ch.channelId, target); err != nil {
+			return nil, err
+		}
+		delete(ch.bans, target)
 		return m, nil
 	}
 	return nil, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(m.GetMsg()))
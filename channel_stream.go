@@ -0,0 +1,196 @@
+package accord
+
+import (
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/qvntm/accord/pb"
+)
+
+// ResumeAfterTrailerKey is the key of the trailer of channel streams, which
+// are closed since their clients couldn't keep up. Its value is the Id of the
+// last message sent to the client, and newer messages can be fetched with
+// GetMessages.
+const ResumeAfterTrailerKey = "accord-resume-after"
+
+// subscribedHeaderKey is the key of the header of channel streams, which is
+// sent once the stream is registered with the channel. Streams rejected by
+// the server end without headers, and gRPC doesn't report it as an error.
+const subscribedHeaderKey = "accord-subscribed"
+
+// streamFlushTimeout bounds the time, for which responses still queued for
+// the stream closed by the server are being sent, e.g. to clients, which
+// have stopped reading.
+const streamFlushTimeout = 5 * time.Second
+
+// errSlowConsumer closes streams, whose queues have overflown with
+// OverflowDisconnect policy.
+var errSlowConsumer = status.Error(codes.ResourceExhausted, "client cannot keep up with the channel")
+
+// StreamMetrics counts what has happened to responses, which didn't fit into
+// queues of channel streams, since the server was created.
+type StreamMetrics struct {
+	// DroppedMessages is the number of responses, which have never been sent
+	// since the queues were full.
+	DroppedMessages uint64
+	// DisconnectedSlowConsumers is the number of streams, which have been
+	// closed since their queues were full.
+	DisconnectedSlowConsumers uint64
+}
+
+// streamQueues configures queues of channel streams and counts their
+// overflows. It is shared by all channels of the server.
+type streamQueues struct {
+	// counters are accessed atomically, so they go first to be 64-bit aligned
+	dropped      uint64
+	disconnected uint64
+	config       StreamsConfig
+}
+
+func newStreamQueues(config StreamsConfig) *streamQueues {
+	return &streamQueues{config: config}
+}
+
+func (q *streamQueues) metrics() StreamMetrics {
+	return StreamMetrics{
+		DroppedMessages:           atomic.LoadUint64(&q.dropped),
+		DisconnectedSlowConsumers: atomic.LoadUint64(&q.disconnected),
+	}
+}
+
+// channelStream is the stream of a user, which is currently streaming with the channel.
+// Responses are queued by the channel and sent by a separate goroutine, so
+// that slow clients don't hold up the channel.
+type channelStream struct {
+	// lastMessageID is the greatest Id of messages sent to the client. It is
+	// accessed atomically, so it goes first to be 64-bit aligned.
+	lastMessageID uint64
+	stream        pb.Chat_ChannelStreamServer
+	// closec receives the error, with which the stream has to be terminated
+	// by the server, e.g. when the user is kicked. It is buffered.
+	closec chan error
+	// queue holds responses, which haven't been sent yet
+	queue chan *pb.ChannelStreamResponse
+	// flush is set before stopc is closed, if queued responses have to be
+	// sent before the sending goroutine stops
+	flush bool
+	// stopc is closed to stop the sending goroutine, which closes stoppedc
+	stopc    chan struct{}
+	stoppedc chan struct{}
+}
+
+// newChannelStream returns the stream, whose client has already been sent all
+// the messages up to lastMessageID. Queued responses are sent after start.
+func newChannelStream(stream pb.Chat_ChannelStreamServer, queueSize int, lastMessageID uint64) *channelStream {
+	s := &channelStream{
+		stream:        stream,
+		closec:        make(chan error, 1),
+		queue:         make(chan *pb.ChannelStreamResponse, queueSize),
+		lastMessageID: lastMessageID,
+		stopc:         make(chan struct{}),
+		stoppedc:      make(chan struct{}),
+	}
+	return s
+}
+
+// start sends the header of the stream, which tells the client that the
+// stream has been registered, and then responses queued for the stream.
+func (s *channelStream) start() {
+	go s.sendQueued()
+}
+
+// sendQueued sends queued responses until the stream is stopped. Sending is
+// unblocked by the cancellation of the stream's context once the handler of
+// the stream returns, so the handler never waits for it.
+func (s *channelStream) sendQueued() {
+	defer close(s.stoppedc)
+	if err := s.stream.SendHeader(metadata.Pairs(subscribedHeaderKey, "true")); err != nil {
+		log.Printf("Could not send channel stream header: %v\n", err)
+		return
+	}
+	ctx := s.stream.Context()
+	for {
+		select {
+		case res := <-s.queue:
+			if !s.send(res) {
+				return
+			}
+		case <-s.stopc:
+			// nothing is queued anymore, so the queue can be drained this way
+			for s.flush && len(s.queue) > 0 {
+				if !s.send(<-s.queue) {
+					return
+				}
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *channelStream) send(res *pb.ChannelStreamResponse) bool {
+	if err := s.stream.Send(res); err != nil {
+		log.Printf("Could not send channel stream response: %v\n", err)
+		return false
+	}
+	if messageID := res.GetUserMsg().GetMessageId(); messageID > atomic.LoadUint64(&s.lastMessageID) {
+		atomic.StoreUint64(&s.lastMessageID, messageID)
+	}
+	return true
+}
+
+// enqueue queues the response to be sent. If the queue is full, the oldest
+// responses are dropped with OverflowDropOldest policy, and false is returned
+// with OverflowDisconnect policy, so the stream has to be closed.
+func (s *channelStream) enqueue(res *pb.ChannelStreamResponse, queues *streamQueues) bool {
+	for {
+		select {
+		case s.queue <- res:
+			return true
+		default:
+		}
+
+		if queues.config.OverflowPolicy != OverflowDropOldest {
+			atomic.AddUint64(&queues.dropped, uint64(len(s.queue))+1)
+			atomic.AddUint64(&queues.disconnected, 1)
+			return false
+		}
+		// the queue may have been emptied by the sending goroutine meanwhile
+		select {
+		case <-s.queue:
+			atomic.AddUint64(&queues.dropped, 1)
+		default:
+		}
+	}
+}
+
+// stop stops sending responses. If flush is set, the ones, which are still
+// queued, are sent first, but for no longer than streamFlushTimeout. Otherwise
+// stop doesn't wait for the response being sent, which may be blocked by the
+// client, which isn't reading. Nothing can be queued once the stream is stopped.
+func (s *channelStream) stop(flush bool) {
+	s.flush = flush
+	close(s.stopc)
+	if !flush {
+		return
+	}
+	select {
+	case <-s.stoppedc:
+	case <-time.After(streamFlushTimeout):
+		log.Println("Timed out sending queued channel stream responses")
+	}
+}
+
+// resumeAfterTrailer tells the client, where to resume after it has been
+// disconnected. Responses being sent, when the stream is stopped, may still
+// be received by the client, which gets them again after resuming.
+func (s *channelStream) resumeAfterTrailer() metadata.MD {
+	return metadata.Pairs(ResumeAfterTrailerKey, strconv.FormatUint(atomic.LoadUint64(&s.lastMessageID), 10))
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/qvntm/accord/pb"
 )
@@ -25,13 +31,27 @@ type StreamResponseCommunication struct {
 	Closec chan<- struct{}
 }
 
+// ServerStreamResponseCommunication is used as a communication interface for
+// users of this package who use "SubscribeToServer" function.
+type ServerStreamResponseCommunication struct {
+	Resc   <-chan *ServerStreamResponse
+	Closec chan<- struct{}
+}
+
 type AccordClient struct {
 	authClient      *AuthClient
+	authInterceptor *ClientAuthInterceptor
+	// conn is the authenticated connection used by ChatClient
+	conn            *grpc.ClientConn
 	serverAddr      string
 	transportOption grpc.DialOption
 	pb.ChatClient
 	Username string
 	ServerID uint64
+
+	// mutex guards Channels and the channels in it, which are also updated by
+	// goroutines receiving from streams.
+	mutex    sync.Mutex
 	Channels map[uint64]*ClientChannel
 }
 
@@ -39,6 +59,7 @@ func NewAccordClient(serverID uint64) *AccordClient {
 	return &AccordClient{
 		Username: "",
 		ServerID: serverID,
+		Channels: make(map[uint64]*ClientChannel),
 	}
 }
 
@@ -46,23 +67,55 @@ func (c *AccordClient) AuthClient() *AuthClient {
 	return c.authClient
 }
 
+// Connect connects to the server, whose certificate is signed by the CA in
+// DefaultCAFile.
 func (c *AccordClient) Connect(addr string) error {
-	tlsCredentials, err := loadTLSCredentials()
+	return c.ConnectWithCA(addr, DefaultCAFile)
+}
+
+// ConnectWithCA connects to the server, whose certificate is signed by the CA
+// in caFile. Users have to log in with their passwords.
+func (c *AccordClient) ConnectWithCA(addr string, caFile string) error {
+	tlsCredentials, err := loadTLSCredentials(caFile)
 	if err != nil {
-		log.Fatal("cannot load TLS credentials:", err)
+		return fmt.Errorf("cannot load TLS credentials: %w", err)
 	}
+	_, err = c.connect(addr, tlsCredentials)
+	return err
+}
+
+// ConnectWithCertificate connects to the server, which authenticates the client
+// by the certificate instead of a password, so no login is needed. The server
+// has to have client certificate authentication enabled, and its certificate
+// has to be signed by the CA in caFile.
+func (c *AccordClient) ConnectWithCertificate(addr string, caFile string, certFile string, keyFile string) error {
+	tlsCredentials, err := loadClientTLSCredentials(caFile, certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	conn, err := c.connect(addr, tlsCredentials)
+	if err != nil {
+		return err
+	}
+
+	// the same connection is authenticated by the certificate
+	c.ChatClient = pb.NewChatClient(conn)
+	return nil
+}
+
+func (c *AccordClient) connect(addr string, tlsCredentials credentials.TransportCredentials) (*grpc.ClientConn, error) {
 	c.transportOption = grpc.WithTransportCredentials(tlsCredentials)
 
 	conn, err := grpc.Dial(addr, c.transportOption)
 	if err != nil {
 		log.Print("Failed to connect to server:", err)
-		return err
+		return nil, err
 	}
 
 	c.authClient = NewAuthClient(conn)
 	c.serverAddr = addr
 	log.Println("Successfully started AuthClient")
-	return nil
+	return conn, nil
 }
 
 func (c *AccordClient) CreateUser(username string, password string) error {
@@ -87,6 +140,27 @@ func (c *AccordClient) CreateChannel(name string, isPublic bool) (uint64, error)
 	return channelID.GetChannelId(), err
 }
 
+// OpenDirectChannel returns the Id of the direct channel of the user and the
+// other users, which is created if it doesn't exist yet. The channel has to
+// be fetched by GetChannel before streaming with it.
+func (c *AccordClient) OpenDirectChannel(usernames ...string) (uint64, error) {
+	if c.ChatClient == nil {
+		return 0, fmt.Errorf("Login required")
+	}
+
+	req := &pb.OpenDirectChannelRequest{
+		Usernames: usernames,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.OpenDirectChannel(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return res.GetChannelId(), nil
+}
+
 // RemoveChannel permanently deletes the channel and all of its data.
 func (c *AccordClient) RemoveChannel(channelID uint64) error {
 	if c.ChatClient == nil {
@@ -117,6 +191,9 @@ func (c *AccordClient) GetChannels() error {
 		return err
 	}
 
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	metas := res.GetChannelMetas()
 	// Remove non-existing channels
 	for k := range c.Channels {
@@ -128,7 +205,18 @@ func (c *AccordClient) GetChannels() error {
 
 	// Update channel metadatas
 	for k, meta := range metas {
-		c.Channels[k] = NewClientChannel(k, meta.Name, meta.IsPublic)
+		channel, ok := c.Channels[k]
+		if ok {
+			channel.Name = meta.Name
+			channel.IsPublic = meta.IsPublic
+		} else {
+			channel = NewClientChannel(k, meta.Name, meta.IsPublic)
+			c.Channels[k] = channel
+		}
+		channel.IsDirect = meta.GetIsDirect()
+		channel.LastReadID = meta.GetLastReadId()
+		channel.UnreadCount = meta.GetUnreadCount()
+		channel.MentionCount = meta.GetMentionCount()
 	}
 
 	return nil
@@ -150,10 +238,17 @@ func (c *AccordClient) GetChannel(channelID uint64) error {
 		return err
 	}
 
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	data := res.GetChannel()
+	if _, ok := c.Channels[channelID]; !ok {
+		c.Channels[channelID] = NewClientChannel(channelID, data.GetName(), data.GetIsPublic())
+	}
 	c.Channels[channelID].Name = data.GetName()
 	c.Channels[channelID].PinnedMsgId = data.GetPinnedMsgId()
 	c.Channels[channelID].IsPublic = data.GetIsPublic()
+	c.Channels[channelID].IsDirect = data.GetIsDirect()
 
 	users := data.GetUsers()
 
@@ -162,18 +257,398 @@ func (c *AccordClient) GetChannel(channelID uint64) error {
 		_, ok := users[uname]
 		if !ok {
 			delete(c.Channels[channelID].Users, uname)
+			delete(c.Channels[channelID].Presences, uname)
 		}
 	}
 
 	for uname, user := range users {
 		c.Channels[channelID].Users[uname] = Role(user.GetRole())
+		c.Channels[channelID].Presences[uname] = getPresence(user.GetPresence())
+	}
+
+	roles := make(map[Role]RoleDefinition)
+	rolesWithPermission := make(map[Permission][]Role)
+	for _, r := range data.GetRoles() {
+		def := getRoleDefinition(r)
+		roles[def.Role] = def
+		for _, perm := range def.Permissions {
+			rolesWithPermission[perm] = append(rolesWithPermission[perm], def.Role)
+		}
+	}
+	c.Channels[channelID].Roles = roles
+	c.Channels[channelID].RolesWithPermission = rolesWithPermission
+
+	bans := make(map[string]Ban)
+	for _, b := range data.GetBans() {
+		bans[b.GetUsername()] = getBan(b)
 	}
+	c.Channels[channelID].Bans = bans
+
+	invites := make(map[string]Invite)
+	for _, i := range data.GetInvites() {
+		invites[i.GetUsername()] = getInvite(i)
+	}
+	c.Channels[channelID].Invites = invites
+	joinRequests := make(map[string]JoinRequest)
+	for _, r := range data.GetJoinRequests() {
+		joinRequests[r.GetUsername()] = getJoinRequest(r)
+	}
+	c.Channels[channelID].JoinRequests = joinRequests
+	c.Channels[channelID].IsFetched = true
 
 	return nil
 }
 
+// SetPresence changes the presence of the user on all of the user's
+// connections, and returns the resulting presence. Only online and idle
+// statuses can be set.
+func (c *AccordClient) SetPresence(presenceStatus PresenceStatus, customStatus string) (Presence, error) {
+	if c.ChatClient == nil {
+		return Presence{}, fmt.Errorf("Login required")
+	}
+
+	req := &pb.SetPresenceRequest{
+		Status:       AccordToPBPresenceStatuses[presenceStatus],
+		CustomStatus: customStatus,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.SetPresence(ctx, req)
+	if err != nil {
+		return Presence{}, err
+	}
+	return getPresence(res.GetPresence()), nil
+}
+
+// InviteUser invites the user to join the channel with the role. The user
+// becomes a member of the channel after accepting the invite.
+func (c *AccordClient) InviteUser(channelID uint64, username string, role Role) error {
+	if c.ChatClient == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	pbRole, customRoleID := getPBRole(role)
+	req := &pb.InviteUserRequest{
+		ChannelId:    channelID,
+		Username:     username,
+		Role:         pbRole,
+		CustomRoleId: customRoleID,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ChatClient.InviteUser(ctx, req)
+	return err
+}
+
+// GetInvites returns pending invites of the user to all channels.
+func (c *AccordClient) GetInvites() ([]Invite, error) {
+	if c.ChatClient == nil {
+		return nil, fmt.Errorf("Login required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.GetInvites(ctx, &pb.GetInvitesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	invites := make([]Invite, len(res.GetInvites()))
+	for i, invite := range res.GetInvites() {
+		invites[i] = getInvite(invite)
+	}
+	return invites, nil
+}
+
+// RespondToInvite accepts or declines the invite of the user to the channel.
+func (c *AccordClient) RespondToInvite(channelID uint64, accept bool) error {
+	if c.ChatClient == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	req := &pb.RespondToInviteRequest{
+		ChannelId: channelID,
+		Accept:    accept,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ChatClient.RespondToInvite(ctx, req)
+	return err
+}
+
+// RequestToJoin requests to join the public channel with the role. The user
+// becomes a member of the channel after the request is approved.
+func (c *AccordClient) RequestToJoin(channelID uint64, role Role) error {
+	if c.ChatClient == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	pbRole, customRoleID := getPBRole(role)
+	req := &pb.RequestToJoinRequest{
+		ChannelId:    channelID,
+		Role:         pbRole,
+		CustomRoleId: customRoleID,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ChatClient.RequestToJoin(ctx, req)
+	return err
+}
+
+// ReviewJoinRequest approves or rejects the request of the user to join the channel.
+func (c *AccordClient) ReviewJoinRequest(channelID uint64, username string, approve bool) error {
+	if c.ChatClient == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	req := &pb.ReviewJoinRequestRequest{
+		ChannelId: channelID,
+		Username:  username,
+		Approve:   approve,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ChatClient.ReviewJoinRequest(ctx, req)
+	return err
+}
+
+// CreateInviteCode creates a code, which lets users join the channel with the
+// role. Zero expiresAt means that the code never expires, and zero maxUses
+// means that the code can be used any number of times.
+func (c *AccordClient) CreateInviteCode(channelID uint64, role Role, expiresAt time.Time, maxUses uint32) (InviteCode, error) {
+	if c.ChatClient == nil {
+		return InviteCode{}, fmt.Errorf("Login required")
+	}
+
+	pbRole, customRoleID := getPBRole(role)
+	req := &pb.CreateInviteCodeRequest{
+		ChannelId:    channelID,
+		Role:         pbRole,
+		CustomRoleId: customRoleID,
+		MaxUses:      maxUses,
+	}
+	var err error
+	if req.ExpiresAt, err = getPBTimestamp(expiresAt); err != nil {
+		return InviteCode{}, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.CreateInviteCode(ctx, req)
+	if err != nil {
+		return InviteCode{}, err
+	}
+	return getInviteCode(res.GetInviteCode()), nil
+}
+
+// GetInviteCodes returns all invite codes of the channel sorted by the time
+// they were created.
+func (c *AccordClient) GetInviteCodes(channelID uint64) ([]InviteCode, error) {
+	if c.ChatClient == nil {
+		return nil, fmt.Errorf("Login required")
+	}
+
+	req := &pb.GetInviteCodesRequest{
+		ChannelId: channelID,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.GetInviteCodes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]InviteCode, len(res.GetInviteCodes()))
+	for i, code := range res.GetInviteCodes() {
+		codes[i] = getInviteCode(code)
+	}
+	return codes, nil
+}
+
+// RevokeInviteCode removes the invite code of the channel.
+func (c *AccordClient) RevokeInviteCode(channelID uint64, code string) error {
+	if c.ChatClient == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	req := &pb.RevokeInviteCodeRequest{
+		ChannelId: channelID,
+		Code:      code,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.ChatClient.RevokeInviteCode(ctx, req)
+	return err
+}
+
+// RedeemInviteCode makes the user a member of the channel of the invite code,
+// and returns the Id of the channel.
+func (c *AccordClient) RedeemInviteCode(code string) (uint64, error) {
+	if c.ChatClient == nil {
+		return 0, fmt.Errorf("Login required")
+	}
+
+	req := &pb.RedeemInviteCodeRequest{
+		Code: code,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.RedeemInviteCode(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return res.GetChannelId(), nil
+}
+
+// GetMessages fetches one page of the channel's history and merges it into
+// the channel's Messages, which are kept sorted by message Ids. Only messages
+// with Ids between afterID and beforeID (both exclusive) are fetched, where zero
+// means no bound. If only afterID is set, the oldest messages after it are
+// fetched, otherwise the newest ones before beforeID. It returns the number of
+// fetched messages, which is less than pageSize only when the page is the last one.
+func (c *AccordClient) GetMessages(channelID uint64, beforeID uint64, afterID uint64, pageSize int32) (int, error) {
+	return c.getMessages(channelID, beforeID, afterID, pageSize, false)
+}
+
+// GetMessagesWithSeenBy fetches messages the same way as GetMessages, and
+// also who has read each of them.
+func (c *AccordClient) GetMessagesWithSeenBy(channelID uint64, beforeID uint64, afterID uint64, pageSize int32) (int, error) {
+	return c.getMessages(channelID, beforeID, afterID, pageSize, true)
+}
+
+func (c *AccordClient) getMessages(channelID uint64, beforeID uint64, afterID uint64, pageSize int32, includeSeenBy bool) (int, error) {
+	if c.ChatClient == nil {
+		return 0, fmt.Errorf("Login required")
+	}
+	channel, ok := c.channel(channelID)
+	if !ok {
+		return 0, fmt.Errorf("there is no channel with id %d in the server or it has not been fetched yet", channelID)
+	}
+
+	req := &pb.GetMessagesRequest{
+		ChannelId:     channelID,
+		BeforeId:      beforeID,
+		AfterId:       afterID,
+		PageSize:      pageSize,
+		IncludeSeenBy: includeSeenBy,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.GetMessages(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	messages := res.GetMessages()
+	channel.Messages = mergeMessages(channel.Messages, messages)
+	for _, m := range messages {
+		channel.updateThread(getMessage(m))
+	}
+
+	return len(messages), nil
+}
+
+// GetThread fetches one page of replies in the thread started by the message
+// and merges them into the channel's Threads. Replies are paged the same way
+// as messages by GetMessages, and the number of fetched replies is returned.
+func (c *AccordClient) GetThread(channelID uint64, threadRootID uint64, beforeID uint64, afterID uint64, pageSize int32) (int, error) {
+	if c.ChatClient == nil {
+		return 0, fmt.Errorf("Login required")
+	}
+	channel, ok := c.channel(channelID)
+	if !ok {
+		return 0, fmt.Errorf("there is no channel with id %d in the server or it has not been fetched yet", channelID)
+	}
+
+	req := &pb.GetThreadRequest{
+		ChannelId:    channelID,
+		ThreadRootId: threadRootID,
+		BeforeId:     beforeID,
+		AfterId:      afterID,
+		PageSize:     pageSize,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.ChatClient.GetThread(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	thread, ok := channel.Threads[threadRootID]
+	if !ok {
+		thread = &Thread{RootID: threadRootID}
+		channel.Threads[threadRootID] = thread
+	}
+	if res.GetRoot() != nil {
+		root := getMessage(res.GetRoot())
+		thread.ReplyCount = root.ReplyCount
+		thread.LastReplyAt = root.LastReplyAt
+	}
+	thread.Replies = mergeMessages(thread.Replies, res.GetReplies())
+
+	return len(res.GetReplies()), nil
+}
+
+// channel returns the channel of the client with the Id.
+func (c *AccordClient) channel(channelID uint64) (*ClientChannel, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	channel, ok := c.Channels[channelID]
+	return channel, ok
+}
+
+// fetchedChannel returns the channel of the client with the Id, if it has
+// been fetched.
+func (c *AccordClient) fetchedChannel(channelID uint64) (*ClientChannel, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	channel, ok := c.Channels[channelID]
+	if !ok {
+		return nil, fmt.Errorf("there is no channel with id %d in the server or it has not been fetched yet", channelID)
+	}
+	if !channel.IsFetched {
+		return nil, fmt.Errorf("channel with id %d has not been fetched yet", channelID)
+	}
+	return channel, nil
+}
+
+// mergeMessages merges fetched messages into messages sorted by their Ids.
+func mergeMessages(messages []Message, fetched []*pb.Message) []Message {
+	byID := make(map[uint64]int, len(messages))
+	for i, msg := range messages {
+		byID[msg.MessageID] = i
+	}
+	for _, m := range fetched {
+		msg := getMessage(m)
+		if i, ok := byID[msg.MessageID]; ok {
+			messages[i] = msg
+		} else {
+			messages = append(messages, msg)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].MessageID < messages[j].MessageID
+	})
+	return messages
+}
+
 func (c *AccordClient) Login(username string, password string) error {
-	interceptor, err := NewClientAuthInterceptor(c.authClient, username, password, 30*time.Second)
+	interceptor, err := NewClientAuthInterceptor(c.authClient, username, password)
 	if err != nil {
 		log.Print("Could not authenticate: ", err)
 		return err
@@ -186,22 +661,54 @@ func (c *AccordClient) Login(username string, password string) error {
 		grpc.WithStreamInterceptor(interceptor.Stream()),
 	)
 	if err != nil {
+		interceptor.Stop()
 		log.Print("Cannot connect to server: ", err)
 		return err
 	}
 
+	// the previous session, if any, is replaced but not revoked
+	if c.authInterceptor != nil {
+		c.authInterceptor.Stop()
+		c.conn.Close()
+	}
+	c.authInterceptor = interceptor
+	c.conn = conn
 	c.ChatClient = pb.NewChatClient(conn)
 	return nil
 }
 
+// Logout revokes the access token of the client, or all the tokens of the user
+// if allSessions is set, and closes the authenticated connection.
+func (c *AccordClient) Logout(allSessions bool) error {
+	if c.authInterceptor == nil {
+		return fmt.Errorf("Login required")
+	}
+
+	c.authInterceptor.Stop()
+	err := c.authClient.Logout(c.authInterceptor.AccessToken(), c.authInterceptor.RefreshToken(), allSessions)
+	if closeErr := c.conn.Close(); closeErr != nil {
+		log.Print("Cannot close connection to server: ", closeErr)
+	}
+	c.authInterceptor = nil
+	c.conn = nil
+	c.ChatClient = nil
+	c.mutex.Lock()
+	for _, channel := range c.Channels {
+		channel.Stream = nil
+	}
+	c.mutex.Unlock()
+	return err
+}
+
 // Subscribe returns the channel, which will send all the updates about the channel.
 func (c *AccordClient) Subscribe(channelID uint64) (*StreamResponseCommunication, error) {
-	channel, ok := c.Channels[channelID]
-	if !ok {
-		return nil, fmt.Errorf("there is no channel with id %d in the server or it has not been fetched yet", channelID)
+	channel, err := c.fetchedChannel(channelID)
+	if err != nil {
+		return nil, err
 	}
-	if !channel.IsFetched {
-		return nil, fmt.Errorf("channel with id %d has not been fetched yet", channelID)
+	stream, err := c.channelStream(channel)
+	if err != nil {
+		return nil, err
 	}
 
 	// TODO: I think this needs to be reorganized.
@@ -212,9 +719,19 @@ func (c *AccordClient) Subscribe(channelID uint64) (*StreamResponseCommunication
 	go func() {
 		defer close(resc)
 		for {
-			res, err := channel.Stream.Recv()
+			res, err := stream.Recv()
 			if err != nil {
 				log.Printf("Terminating client stream's recv goroutine: %v", err)
+				// the stream may be closed by the server, e.g. when the user is
+				// kicked, so let the next Subscribe open a new one
+				c.mutex.Lock()
+				if channel.Stream == stream {
+					channel.Stream = nil
+				}
+				if status.Code(err) == codes.ResourceExhausted {
+					channel.ResumeAfterID = getResumeAfterID(stream)
+				}
+				c.mutex.Unlock()
 				return
 			}
 
@@ -235,18 +752,158 @@ func (c *AccordClient) Subscribe(channelID uint64) (*StreamResponseCommunication
 	return resComm, nil
 }
 
+// channelStream returns the stream with the channel, which is opened if the
+// channel isn't being streamed with yet. New streams are subscribed to the
+// channel, so that updates are received without sending anything else.
+func (c *AccordClient) channelStream(channel *ClientChannel) (pb.Chat_ChannelStreamClient, error) {
+	c.mutex.Lock()
+	stream := channel.Stream
+	c.mutex.Unlock()
+	if stream != nil {
+		return stream, nil
+	}
+
+	stream, err := c.ChatClient.ChannelStream(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot open stream with channel %d: %v", channel.ChannelId, err)
+	}
+	req := &pb.ChannelStreamRequest{
+		ChannelId: channel.ChannelId,
+		Msg: &pb.ChannelStreamRequest_SubscribeMsg{
+			SubscribeMsg: &pb.ChannelStreamRequest_SubscribeMessage{},
+		},
+	}
+	if err := stream.Send(req); err != nil {
+		return nil, fmt.Errorf("cannot subscribe to channel %d: %v", channel.ChannelId, err)
+	}
+	// wait for the server to register the stream, so that no updates are
+	// missed, and errors, e.g. about the user being banned, are returned
+	header, err := stream.Header()
+	if err != nil {
+		return nil, err
+	}
+	if len(header.Get(subscribedHeaderKey)) == 0 {
+		_, err := stream.Recv()
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// the channel may have been subscribed to concurrently
+	if channel.Stream != nil {
+		stream.CloseSend()
+		return channel.Stream, nil
+	}
+	channel.Stream = stream
+	return stream, nil
+}
+
+// getResumeAfterID returns the Id of the last message sent by the server to
+// the stream, which has been closed since the client couldn't keep up.
+func getResumeAfterID(stream pb.Chat_ChannelStreamClient) uint64 {
+	values := stream.Trailer().Get(ResumeAfterTrailerKey)
+	if len(values) == 0 {
+		return 0
+	}
+	id, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		log.Printf("Invalid trailer %s: %v", ResumeAfterTrailerKey, err)
+		return 0
+	}
+	return id
+}
+
+// SubscribeToServer starts streaming server-wide events. Channels of the client
+// are kept up to date with the events, which are also sent to the returned channel.
+func (c *AccordClient) SubscribeToServer() (*ServerStreamResponseCommunication, error) {
+	if c.ChatClient == nil {
+		return nil, fmt.Errorf("Login required")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.ChatClient.ServerStream(ctx, &pb.ServerStreamRequest{})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot open server stream: %v", err)
+	}
+	// wait for the server to register the stream, so that no events are missed
+	if _, err := stream.Header(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot open server stream: %v", err)
+	}
+
+	resc, closeresc := make(chan *ServerStreamResponse), make(chan struct{})
+	go func() {
+		defer close(resc)
+		defer cancel()
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				log.Printf("Terminating server stream's recv goroutine: %v", err)
+				return
+			}
+
+			resEvent := getServerStreamResponse(res)
+			if resEvent == nil {
+				continue
+			}
+			c.applyServerEvent(resEvent)
+			select {
+			case <-closeresc:
+				log.Println("Terminating server stream's send goroutine by the signal of receiver.")
+				return
+			case resc <- resEvent:
+			}
+		}
+	}()
+
+	resComm := &ServerStreamResponseCommunication{
+		Resc:   resc,
+		Closec: closeresc,
+	}
+	return resComm, nil
+}
+
+// applyServerEvent updates channels of the client according to the server-wide event.
+func (c *AccordClient) applyServerEvent(res *ServerStreamResponse) {
+	action := res.GetChannelAction()
+	if action == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	switch action.GetAction().(type) {
+	case *AddChannelServerStreamResponse:
+		add := action.GetAddChannel()
+		if _, ok := c.Channels[action.ChannelID]; !ok {
+			c.Channels[action.ChannelID] = NewClientChannel(action.ChannelID, add.Name, add.IsPublic)
+			c.Channels[action.ChannelID].IsDirect = add.IsDirect
+		}
+	case *RemoveChannelServerStreamResponse:
+		delete(c.Channels, action.ChannelID)
+	case *RenameChannelServerStreamResponse:
+		if channel, ok := c.Channels[action.ChannelID]; ok {
+			channel.Name = action.GetRenameChannel().NewName
+		}
+	}
+}
+
 func (c *AccordClient) Send(msg *ChannelStreamRequest) error {
-	channel, ok := c.Channels[msg.ChannelID]
-	if !ok {
-		return fmt.Errorf("there is no channel with id %d in the server or it has not been fetched yet", msg.ChannelID)
+	channel, err := c.fetchedChannel(msg.ChannelID)
+	if err != nil {
+		return err
 	}
-	if !channel.IsFetched {
-		return fmt.Errorf("channel with id %d has not been fetched yet", msg.ChannelID)
+	c.mutex.Lock()
+	stream := channel.Stream
+	c.mutex.Unlock()
+	if stream == nil {
+		return fmt.Errorf("channel with id %d has not been subscribed to yet", msg.ChannelID)
 	}
 
 	req := getChannelStreamRequest(msg)
-	if err := channel.Stream.Send(req); err != nil {
-		return fmt.Errorf("Failed to send request %v to the channel stream %v", req, channel.Stream)
+	if err := stream.Send(req); err != nil {
+		return fmt.Errorf("Failed to send request %v to the channel stream %v", req, stream)
 	}
 
 	return nil
@@ -1,15 +1,67 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/qvntm/accord"
 )
 
 func main() {
-	s := accord.NewAccordServer()
-	if _, err := s.Listen("0.0.0.0:50051"); err != nil {
-		log.Fatalf("Server failed to listen: %v", err)
+	// options are applied in the order: defaults, config file, environment, flags
+	configPath := flag.String("config", "", "path to the JSON config file")
+	for _, option := range accord.ServerConfigOptions() {
+		flag.String(option.Name, "", option.Usage+" (env "+option.EnvKey()+")")
+	}
+	flag.Parse()
+
+	// errors are returned up to main, so that the storage is closed before exiting
+	if err := run(*configPath); err != nil {
+		log.Fatalf("Server failed: %v", err)
+	}
+}
+
+// run starts the server configured by the config file, the environment and
+// the flags, and returns once the server has stopped.
+func run(configPath string) error {
+	config := accord.DefaultServerConfig()
+	if configPath != "" {
+		var err error
+		if config, err = accord.LoadServerConfig(configPath); err != nil {
+			return fmt.Errorf("cannot load config: %w", err)
+		}
+	}
+	if err := config.ApplyEnv(os.LookupEnv); err != nil {
+		return fmt.Errorf("cannot load config from environment: %w", err)
+	}
+	var flagErr error
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "config" || flagErr != nil {
+			return
+		}
+		if err := config.Set(f.Name, f.Value.String()); err != nil {
+			flagErr = fmt.Errorf("cannot load config from flags: %w", err)
+		}
+	})
+	if flagErr != nil {
+		return flagErr
+	}
+
+	storage, err := config.Storage.OpenStorage()
+	if err != nil {
+		return fmt.Errorf("cannot open storage: %w", err)
+	}
+	defer storage.Close()
+
+	s, err := accord.NewAccordServerWithConfig(config, storage)
+	if err != nil {
+		return fmt.Errorf("cannot create server: %w", err)
+	}
+	if _, err := s.Listen(config.ListenAddress); err != nil {
+		return fmt.Errorf("cannot listen: %w", err)
 	}
 	s.Start()
+	return nil
 }
@@ -0,0 +1,438 @@
+package accord
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultListenAddress        = "0.0.0.0:50051"
+	defaultTokenDuration        = 15 * time.Minute
+	defaultRefreshTokenDuration = 30 * 24 * time.Hour
+	defaultStreamQueueSize      = 256
+)
+
+// Duration is time.Duration, which is written in config files as a string,
+// e.g. "15m" or "720h".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration has to be a string: %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Modes of client certificate authentication.
+const (
+	// ClientAuthNone doesn't request client certificates
+	ClientAuthNone = "none"
+	// ClientAuthOptional verifies client certificates if clients send them
+	ClientAuthOptional = "optional"
+	// ClientAuthRequire rejects connections without a valid client certificate
+	ClientAuthRequire = "require"
+)
+
+// Subject fields of client certificates, which can be used as usernames.
+const (
+	CertUsernameCommonName = "common_name"
+	CertUsernameEmail      = "email"
+)
+
+// Policies for channel streams, whose outbound queues are full.
+const (
+	// OverflowDropOldest drops the oldest queued responses to make room for new ones
+	OverflowDropOldest = "drop_oldest"
+	// OverflowDisconnect closes the stream, and the client resumes after the
+	// last message it has been sent, which is in the trailer of the stream
+	OverflowDisconnect = "disconnect"
+)
+
+// TLSConfig contains paths to PEM files used by the server for TLS.
+type TLSConfig struct {
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	CAFile   string `json:"ca_file"`
+	// ClientAuth is one of ClientAuthNone, ClientAuthOptional and
+	// ClientAuthRequire. Clients with verified certificates are authenticated
+	// as the user named in the certificate, unless they send a token.
+	ClientAuth string `json:"client_auth"`
+	// ClientCAFile is the certificate of the CA, which signs client
+	// certificates. It has to be set for client certificate authentication,
+	// and it should be a CA, which doesn't sign certificates of servers.
+	ClientCAFile string `json:"client_ca_file"`
+	// ClientCertUsername is the subject field of client certificates, which
+	// contains the username, either CertUsernameCommonName or CertUsernameEmail.
+	ClientCertUsername string `json:"client_cert_username"`
+}
+
+// JWTConfig configures signing of access and refresh tokens.
+type JWTConfig struct {
+	// KeyFile is the PEM file with the RSA, ECDSA P-256 or Ed25519 private
+	// key used for signing tokens. The secret is used if it is not set.
+	KeyFile string `json:"key_file"`
+	// VerificationKeyFiles are PEM files with keys, which are not used for
+	// signing anymore, but still verify tokens, e.g. after rotation.
+	VerificationKeyFiles []string `json:"verification_key_files"`
+	// Secret is the key used for signing tokens with HS256. It is read from
+	// SecretFile if it is not set. If neither is set, a random secret is
+	// generated, so the tokens are not valid after the server is restarted.
+	Secret          string   `json:"secret"`
+	SecretFile      string   `json:"secret_file"`
+	TokenTTL        Duration `json:"token_ttl"`
+	RefreshTokenTTL Duration `json:"refresh_token_ttl"`
+}
+
+// StorageConfig configures where the state of the server is persisted.
+type StorageConfig struct {
+	// Path is the path to the database file. The state is kept in memory
+	// only if it is set to empty.
+	Path string `json:"path"`
+}
+
+// StreamsConfig configures sending of responses to clients streaming with
+// channels. Each stream has its own queue, so that slow clients don't hold up
+// the channel. Queues of server streams have the same size, and they are
+// always disconnected when full.
+type StreamsConfig struct {
+	// QueueSize is the maximal number of responses queued for each stream.
+	QueueSize int `json:"queue_size"`
+	// OverflowPolicy is either OverflowDropOldest or OverflowDisconnect.
+	OverflowPolicy string `json:"overflow_policy"`
+}
+
+// ServerConfig is the configuration of the server.
+type ServerConfig struct {
+	ListenAddress string        `json:"listen_address"`
+	TLS           TLSConfig     `json:"tls"`
+	JWT           JWTConfig     `json:"jwt"`
+	Storage       StorageConfig `json:"storage"`
+	Streams       StreamsConfig `json:"streams"`
+}
+
+// DefaultServerConfig returns the configuration, which is used by the server
+// unless configured otherwise. Its paths are relative to the working directory,
+// unless the config is loaded by LoadServerConfig, and the server reports the
+// files, which are missing, when it is created.
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		ListenAddress: defaultListenAddress,
+		TLS: TLSConfig{
+			CertFile: "../cert/server-cert.pem",
+			KeyFile:  "../cert/server-key.pem",
+			CAFile:   "../cert/ca-cert.pem",
+
+			ClientAuth:         ClientAuthNone,
+			ClientCertUsername: CertUsernameCommonName,
+		},
+		JWT: JWTConfig{
+			TokenTTL:        Duration(defaultTokenDuration),
+			RefreshTokenTTL: Duration(defaultRefreshTokenDuration),
+		},
+		Storage: StorageConfig{
+			Path: "accord.db",
+		},
+		Streams: StreamsConfig{
+			QueueSize:      defaultStreamQueueSize,
+			OverflowPolicy: OverflowDisconnect,
+		},
+	}
+}
+
+// LoadServerConfig reads the configuration from the JSON file. Options, which
+// are missing in the file, have default values. Relative paths, both the ones
+// in the file and the default ones, are relative to the directory of the file,
+// so that the server can be started from any directory.
+func LoadServerConfig(path string) (*ServerConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := DefaultServerConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("cannot parse config file %s: %w", path, err)
+	}
+	dir := filepath.Dir(path)
+	for _, p := range config.paths() {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+	return config, nil
+}
+
+// paths returns pointers to all the paths of files in the config.
+func (c *ServerConfig) paths() []*string {
+	paths := []*string{
+		&c.TLS.CertFile,
+		&c.TLS.KeyFile,
+		&c.TLS.CAFile,
+		&c.TLS.ClientCAFile,
+		&c.JWT.KeyFile,
+		&c.JWT.SecretFile,
+		&c.Storage.Path,
+	}
+	for i := range c.JWT.VerificationKeyFiles {
+		paths = append(paths, &c.JWT.VerificationKeyFiles[i])
+	}
+	return paths
+}
+
+// ConfigOption is an option of ServerConfig, which can be set from a string,
+// e.g. from an environment variable or a command line flag.
+type ConfigOption struct {
+	// Name is the name of the option, e.g. "listen-address"
+	Name  string
+	Usage string
+	set   func(c *ServerConfig, value string) error
+}
+
+// EnvKey returns the environment variable, which overrides the option.
+func (o ConfigOption) EnvKey() string {
+	return "ACCORD_" + strings.ToUpper(strings.ReplaceAll(o.Name, "-", "_"))
+}
+
+func setString(field func(c *ServerConfig) *string) func(c *ServerConfig, value string) error {
+	return func(c *ServerConfig, value string) error {
+		*field(c) = value
+		return nil
+	}
+}
+
+func setStrings(field func(c *ServerConfig) *[]string) func(c *ServerConfig, value string) error {
+	return func(c *ServerConfig, value string) error {
+		*field(c) = nil
+		for _, s := range strings.Split(value, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				*field(c) = append(*field(c), s)
+			}
+		}
+		return nil
+	}
+}
+
+func setInt(field func(c *ServerConfig) *int) func(c *ServerConfig, value string) error {
+	return func(c *ServerConfig, value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setDuration(field func(c *ServerConfig) *Duration) func(c *ServerConfig, value string) error {
+	return func(c *ServerConfig, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = Duration(d)
+		return nil
+	}
+}
+
+var serverConfigOptions = []ConfigOption{
+	{"listen-address", "address to listen on", setString(func(c *ServerConfig) *string { return &c.ListenAddress })},
+	{"tls-cert-file", "path to the server's TLS certificate", setString(func(c *ServerConfig) *string { return &c.TLS.CertFile })},
+	{"tls-key-file", "path to the server's TLS private key", setString(func(c *ServerConfig) *string { return &c.TLS.KeyFile })},
+	{"tls-ca-file", "path to the certificate of the CA", setString(func(c *ServerConfig) *string { return &c.TLS.CAFile })},
+	{"tls-client-auth", "client certificate authentication: none, optional or require", setString(func(c *ServerConfig) *string { return &c.TLS.ClientAuth })},
+	{"tls-client-ca-file", "path to the certificate of the CA, which signs client certificates", setString(func(c *ServerConfig) *string { return &c.TLS.ClientCAFile })},
+	{"tls-client-cert-username", "subject field of client certificates with the username: common_name or email", setString(func(c *ServerConfig) *string { return &c.TLS.ClientCertUsername })},
+	{"jwt-key-file", "path to the private key for signing tokens", setString(func(c *ServerConfig) *string { return &c.JWT.KeyFile })},
+	{"jwt-verification-key-files", "comma-separated paths to keys, which only verify tokens", setStrings(func(c *ServerConfig) *[]string { return &c.JWT.VerificationKeyFiles })},
+	{"jwt-secret", "secret for signing tokens", setString(func(c *ServerConfig) *string { return &c.JWT.Secret })},
+	{"jwt-secret-file", "path to the file with the secret for signing tokens", setString(func(c *ServerConfig) *string { return &c.JWT.SecretFile })},
+	{"token-ttl", "lifetime of access tokens, e.g. 15m", setDuration(func(c *ServerConfig) *Duration { return &c.JWT.TokenTTL })},
+	{"refresh-token-ttl", "lifetime of refresh tokens, e.g. 720h", setDuration(func(c *ServerConfig) *Duration { return &c.JWT.RefreshTokenTTL })},
+	{"storage-path", "path to the database file, the state is kept in memory if empty", setString(func(c *ServerConfig) *string { return &c.Storage.Path })},
+	{"stream-queue-size", "maximal number of responses queued for each channel stream", setInt(func(c *ServerConfig) *int { return &c.Streams.QueueSize })},
+	{"stream-overflow-policy", "what to do with streams, whose queues are full: drop_oldest or disconnect", setString(func(c *ServerConfig) *string { return &c.Streams.OverflowPolicy })},
+}
+
+// ServerConfigOptions returns all the options, which can be set with Set.
+func ServerConfigOptions() []ConfigOption {
+	return append([]ConfigOption(nil), serverConfigOptions...)
+}
+
+// Set sets the option with the given name.
+func (c *ServerConfig) Set(name string, value string) error {
+	for _, o := range serverConfigOptions {
+		if o.Name == name {
+			if err := o.set(c, value); err != nil {
+				return fmt.Errorf("invalid %s: %w", name, err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown option %s", name)
+}
+
+// ApplyEnv overrides options of the config with the environment variables,
+// which are looked up with lookupEnv, e.g. os.LookupEnv.
+func (c *ServerConfig) ApplyEnv(lookupEnv func(key string) (string, bool)) error {
+	for _, o := range serverConfigOptions {
+		if value, ok := lookupEnv(o.EnvKey()); ok {
+			if err := o.set(c, value); err != nil {
+				return fmt.Errorf("invalid %s: %w", o.EnvKey(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks that the config can be used by the server.
+func (c *ServerConfig) Validate() error {
+	if c.ListenAddress == "" {
+		return fmt.Errorf("listen address cannot be empty")
+	}
+	if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+		return fmt.Errorf("TLS certificate and key files have to be set")
+	}
+	switch c.TLS.ClientAuth {
+	case ClientAuthNone:
+	case ClientAuthOptional, ClientAuthRequire:
+		if c.TLS.ClientCAFile == "" {
+			return fmt.Errorf("client CA file has to be set for client certificate authentication")
+		}
+	default:
+		return fmt.Errorf("unknown client auth mode %q", c.TLS.ClientAuth)
+	}
+	switch c.TLS.ClientCertUsername {
+	case CertUsernameCommonName, CertUsernameEmail:
+	default:
+		return fmt.Errorf("unknown client certificate username field %q", c.TLS.ClientCertUsername)
+	}
+	if c.JWT.TokenTTL <= 0 || c.JWT.RefreshTokenTTL <= 0 {
+		return fmt.Errorf("token TTLs have to be positive")
+	}
+	if c.JWT.TokenTTL > c.JWT.RefreshTokenTTL {
+		return fmt.Errorf("token TTL cannot be longer than refresh token TTL")
+	}
+	if c.Streams.QueueSize <= 0 {
+		return fmt.Errorf("stream queue size has to be positive")
+	}
+	switch c.Streams.OverflowPolicy {
+	case OverflowDropOldest, OverflowDisconnect:
+	default:
+		return fmt.Errorf("unknown stream overflow policy %q", c.Streams.OverflowPolicy)
+	}
+	return nil
+}
+
+// certUsername returns the username from the subject of the client certificate.
+// Only certificates issued for client authentication name users, so that e.g.
+// certificates of servers signed by the same CA cannot be used instead.
+func (c *TLSConfig) certUsername(cert *x509.Certificate) (string, error) {
+	if !hasClientAuthUsage(cert) {
+		return "", fmt.Errorf("certificate %s is not issued for client authentication", cert.Subject)
+	}
+	var username string
+	switch c.ClientCertUsername {
+	case CertUsernameCommonName:
+		username = cert.Subject.CommonName
+	case CertUsernameEmail:
+		if len(cert.EmailAddresses) > 0 {
+			username = cert.EmailAddresses[0]
+		}
+	}
+	if username == "" {
+		return "", fmt.Errorf("certificate %s has no %s", cert.Subject, c.ClientCertUsername)
+	}
+	return username, nil
+}
+
+func hasClientAuthUsage(cert *x509.Certificate) bool {
+	for _, usage := range cert.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth {
+			return true
+		}
+	}
+	return false
+}
+
+// jwtSecret returns the configured secret for signing tokens, or a random one.
+func (c *JWTConfig) jwtSecret() (string, error) {
+	if c.Secret != "" {
+		return c.Secret, nil
+	}
+	if c.SecretFile != "" {
+		data, err := ioutil.ReadFile(c.SecretFile)
+		if err != nil {
+			return "", fmt.Errorf("cannot read JWT secret: %w", err)
+		}
+		secret := strings.TrimSpace(string(data))
+		if secret == "" {
+			return "", fmt.Errorf("JWT secret file %s is empty", c.SecretFile)
+		}
+		return secret, nil
+	}
+
+	log.Print("JWT secret is not configured, tokens will be invalidated on restart")
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate JWT secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newJWTManager creates the manager of tokens as configured.
+func (c *JWTConfig) newJWTManager() (*JWTManager, error) {
+	var key *SigningKey
+	if c.KeyFile != "" {
+		var err error
+		if key, err = LoadSigningKey(c.KeyFile); err != nil {
+			return nil, fmt.Errorf("cannot load JWT key: %w", err)
+		}
+	} else {
+		secret, err := c.jwtSecret()
+		if err != nil {
+			return nil, err
+		}
+		key = NewHMACSigningKey("default", []byte(secret))
+	}
+
+	manager, err := NewJWTManagerWithKey(key, time.Duration(c.TokenTTL), time.Duration(c.RefreshTokenTTL))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range c.VerificationKeyFiles {
+		key, err := LoadVerificationKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load JWT verification key: %w", err)
+		}
+		if err := manager.AddVerificationKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return manager, nil
+}
+
+// OpenStorage opens the configured storage.
+func (c *StorageConfig) OpenStorage() (Storage, error) {
+	if c.Path == "" {
+		return NewMemoryStorage(), nil
+	}
+	return NewBoltStorage(c.Path)
+}
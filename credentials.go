@@ -5,23 +5,40 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"google.golang.org/grpc/credentials"
 )
 
-func loadTLSCredentials() (credentials.TransportCredentials, error) {
-	// Load certificate of the CA who signed server's certificate
-	pemServerCA, err := ioutil.ReadFile("../cert/ca-cert.pem")
+// DefaultCAFile is the certificate of the CA who signed server's certificate,
+// which is used by clients unless configured otherwise. It is relative to the
+// working directory.
+const DefaultCAFile = "../cert/ca-cert.pem"
+
+// loadTLSCredentials loads the certificate of the CA who signed server's
+// certificate. Clients, which authenticate with passwords, don't send any
+// certificate.
+func loadTLSCredentials(caFile string) (credentials.TransportCredentials, error) {
+	certPool, err := loadCertPool(caFile)
 	if err != nil {
 		return nil, err
 	}
-	certPool := x509.NewCertPool()
-	if !certPool.AppendCertsFromPEM(pemServerCA) {
-		return nil, fmt.Errorf("failed to add server CA's certificate")
+	return credentials.NewTLS(&tls.Config{RootCAs: certPool}), nil
+}
+
+// loadClientTLSCredentials loads the certificate of the CA who signed server's
+// certificate, and the client's certificate and key, which the server may use
+// to authenticate the client.
+func loadClientTLSCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	// Load certificate of the CA who signed server's certificate
+	certPool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
 	}
 
 	// Load client's certificate and private key
-	clientCert, err := tls.LoadX509KeyPair("../cert/server-cert.pem", "../cert/server-key.pem")
+	clientCert, err := loadX509KeyPair(certFile, keyFile)
 	if err != nil {
 		return nil, err
 	}
@@ -34,3 +51,83 @@ func loadTLSCredentials() (credentials.TransportCredentials, error) {
 
 	return credentials.NewTLS(config), nil
 }
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pemCA, err := readFile("CA certificate", caFile)
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemCA) {
+		return nil, fmt.Errorf("failed to add CA's certificate %s", caFile)
+	}
+	return certPool, nil
+}
+
+// loadServerTLSCredentials loads the server's certificate and key, and the
+// certificate of the CA if it is configured. Client certificates are verified
+// against the client CA if client authentication is enabled.
+func loadServerTLSCredentials(tlsConfig *TLSConfig) (credentials.TransportCredentials, error) {
+	serverCert, err := loadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+	}
+
+	if tlsConfig.CAFile != "" {
+		certPool, err := loadCertPool(tlsConfig.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = certPool
+	}
+
+	switch tlsConfig.ClientAuth {
+	case ClientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	if config.ClientAuth != tls.NoClientCert {
+		certPool, err := loadCertPool(tlsConfig.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = certPool
+	}
+
+	return credentials.NewTLS(config), nil
+}
+
+func loadX509KeyPair(certFile, keyFile string) (tls.Certificate, error) {
+	pemCert, err := readFile("certificate", certFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	pemKey, err := readFile("private key", keyFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	cert, err := tls.X509KeyPair(pemCert, pemKey)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("invalid certificate %s or private key %s: %w", certFile, keyFile, err)
+	}
+	return cert, nil
+}
+
+// readFile reads the file, and the error names the file, which may be
+// missing since its path is relative to an unexpected working directory.
+func readFile(what string, path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		return data, nil
+	}
+	if !filepath.IsAbs(path) {
+		if wd, wdErr := os.Getwd(); wdErr == nil {
+			return nil, fmt.Errorf("cannot read %s %s relative to the working directory %s: %w", what, path, wd, err)
+		}
+	}
+	return nil, fmt.Errorf("cannot read %s %s: %w", what, path, err)
+}
@@ -1,54 +1,237 @@
 package accord
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
-// JWTManager is a JSON web token manager
+// JWTManager is a JSON web token manager. It signs tokens with its current
+// key and verifies them with any of its keys, which is chosen by the Id in
+// the "kid" header of the token.
 type JWTManager struct {
-	secretKey     string
-	tokenDuration time.Duration
+	// mutex guards keys and the current key, which are changed by rotations
+	mutex sync.RWMutex
+	keys  map[string]*SigningKey
+	// current is the key, which signs new tokens
+	current              *SigningKey
+	tokenDuration        time.Duration
+	refreshTokenDuration time.Duration
 }
 
 // UserClaims is a custom JWT claims that contains some user's information
 type UserClaims struct {
 	jwt.StandardClaims
 	Username string `json:"username"`
+	// Refresh is set for refresh tokens, which cannot be used as access tokens
+	Refresh bool `json:"refresh,omitempty"`
 }
 
-// NewJWTManager returns a new JWT manager
-func NewJWTManager(secretKey string, tokenDuration time.Duration) *JWTManager {
-	return &JWTManager{secretKey, tokenDuration}
+// NewJWTManager returns a new JWT manager, which signs tokens with the shared secret
+func NewJWTManager(secretKey string, tokenDuration time.Duration, refreshTokenDuration time.Duration) *JWTManager {
+	manager, _ := NewJWTManagerWithKey(NewHMACSigningKey("default", []byte(secretKey)), tokenDuration, refreshTokenDuration)
+	return manager
 }
 
-// Generate generates and signs a new token for a user
+// NewJWTManagerWithKey returns a new JWT manager, which signs tokens with the key
+func NewJWTManagerWithKey(key *SigningKey, tokenDuration time.Duration, refreshTokenDuration time.Duration) (*JWTManager, error) {
+	if key.signKey == nil {
+		return nil, fmt.Errorf("key %s cannot sign tokens", key.ID)
+	}
+	return &JWTManager{
+		keys:                 map[string]*SigningKey{key.ID: key},
+		current:              key,
+		tokenDuration:        tokenDuration,
+		refreshTokenDuration: refreshTokenDuration,
+	}, nil
+}
+
+// RotateKey makes the key sign all the new tokens. The previous key keeps
+// verifying tokens for the overlap, which should be at least as long as
+// the lifetime of refresh tokens, so that no user is logged out.
+func (manager *JWTManager) RotateKey(key *SigningKey, overlap time.Duration) error {
+	if key.signKey == nil {
+		return fmt.Errorf("key %s cannot sign tokens", key.ID)
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if _, ok := manager.keys[key.ID]; ok {
+		return fmt.Errorf("key %s has already been used", key.ID)
+	}
+
+	retired := *manager.current
+	retired.signKey = nil
+	retired.expiresAt = time.Now().Add(overlap)
+	manager.keys[retired.ID] = &retired
+	manager.keys[key.ID] = key
+	manager.current = key
+	manager.removeExpiredKeys()
+	return nil
+}
+
+// AddVerificationKey adds the key, which only verifies tokens, e.g. the key
+// retired by another server, until it is removed.
+func (manager *JWTManager) AddVerificationKey(key *SigningKey) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if _, ok := manager.keys[key.ID]; ok {
+		return fmt.Errorf("key %s has already been added", key.ID)
+	}
+
+	verificationKey := *key
+	verificationKey.signKey = nil
+	manager.keys[key.ID] = &verificationKey
+	return nil
+}
+
+// RemoveKey removes the key, which is not used for signing anymore.
+func (manager *JWTManager) RemoveKey(id string) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if id == manager.current.ID {
+		return fmt.Errorf("key %s is used for signing", id)
+	}
+	delete(manager.keys, id)
+	return nil
+}
+
+// PublicKeySet returns the public keys, which verify tokens at the moment.
+// Secret HMAC keys are never exported.
+func (manager *JWTManager) PublicKeySet() JSONWebKeySet {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.removeExpiredKeys()
+
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, key := range manager.keys {
+		if jwk, ok := key.JWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+	return set
+}
+
+// removeExpiredKeys removes retired keys after their overlap. It has to be
+// called with the mutex held.
+func (manager *JWTManager) removeExpiredKeys() {
+	now := time.Now()
+	for id, key := range manager.keys {
+		if !key.expiresAt.IsZero() && now.After(key.expiresAt) {
+			delete(manager.keys, id)
+		}
+	}
+}
+
+// verificationKey returns the key with the Id unless it has expired.
+func (manager *JWTManager) verificationKey(id string) (*SigningKey, error) {
+	manager.mutex.RLock()
+	defer manager.mutex.RUnlock()
+
+	key, ok := manager.keys[id]
+	if !ok || (!key.expiresAt.IsZero() && time.Now().After(key.expiresAt)) {
+		return nil, fmt.Errorf("unknown key %q", id)
+	}
+	return key, nil
+}
+
+// Generate generates and signs a new access token for a user
 func (manager *JWTManager) Generate(username string) (string, error) {
-	claims := UserClaims{
+	token, _, err := manager.generate(username, false)
+	return token, err
+}
+
+// GenerateRefresh generates and signs a new refresh token for a user
+func (manager *JWTManager) GenerateRefresh(username string) (string, error) {
+	token, _, err := manager.generate(username, true)
+	return token, err
+}
+
+// generate generates and signs a new token for a user, and also returns its claims.
+// Each token gets a unique Id, so that it can be revoked.
+func (manager *JWTManager) generate(username string, refresh bool) (string, *UserClaims, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, fmt.Errorf("cannot generate token id: %w", err)
+	}
+
+	duration := manager.tokenDuration
+	if refresh {
+		duration = manager.refreshTokenDuration
+	}
+	now := time.Now()
+	claims := &UserClaims{
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(manager.tokenDuration).Unix(),
+			Id:        hex.EncodeToString(id),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(duration).Unix(),
 		},
 		Username: username,
+		Refresh:  refresh,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(manager.secretKey))
+	manager.mutex.RLock()
+	key := manager.current
+	manager.mutex.RUnlock()
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString(key.signKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
 }
 
 // Verify verifies the access token string and return a user claim if the token is valid
 func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
+	claims, err := manager.verify(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Refresh {
+		return nil, fmt.Errorf("invalid token: refresh token cannot be used as access token")
+	}
+	return claims, nil
+}
+
+// VerifyRefresh verifies the refresh token string and return a user claim if the token is valid
+func (manager *JWTManager) VerifyRefresh(refreshToken string) (*UserClaims, error) {
+	claims, err := manager.verify(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.Refresh {
+		return nil, fmt.Errorf("invalid token: access token cannot be used as refresh token")
+	}
+	return claims, nil
+}
+
+func (manager *JWTManager) verify(signedToken string) (*UserClaims, error) {
 	token, err := jwt.ParseWithClaims(
-		accessToken,
+		signedToken,
 		&UserClaims{},
 		func(token *jwt.Token) (interface{}, error) {
-			_, ok := token.Method.(*jwt.SigningMethodHMAC)
-			if !ok {
+			id, _ := token.Header["kid"].(string)
+			key, err := manager.verificationKey(id)
+			if err != nil {
+				return nil, err
+			}
+			// the method is fixed by the key, otherwise e.g. a public key
+			// could be used as an HMAC secret
+			if token.Method.Alg() != key.Method.Alg() {
 				return nil, fmt.Errorf("unexpected token signing method")
 			}
 
-			return []byte(manager.secretKey), nil
+			return key.verifyKey, nil
 		},
 	)
 
@@ -63,3 +246,13 @@ func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
 
 	return claims, nil
 }
+
+// parseUnverified returns claims of the token without verifying it. It is
+// meant for clients, which don't have the key, to learn when the token expires.
+func parseUnverified(signedToken string) (*UserClaims, error) {
+	claims := &UserClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(signedToken, claims); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
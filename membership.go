@@ -0,0 +1,364 @@
+package accord
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/qvntm/accord/pb"
+)
+
+// Invite is a pending invite of the user to the channel.
+type Invite struct {
+	ChannelID   uint64
+	ChannelName string
+	Username    string
+	// Role is the role, which the user gets on accepting the invite.
+	Role      Role
+	InvitedBy string
+	InvitedAt time.Time
+}
+
+// JoinRequest is a pending request of the user to join the public channel.
+type JoinRequest struct {
+	Username string
+	// Role is the role, which the user gets if the request is approved.
+	Role        Role
+	RequestedAt time.Time
+}
+
+// inviteCodeBytes is the number of random bytes in invite codes, which are
+// long enough not to be guessed.
+const inviteCodeBytes = 12
+
+// InviteCode lets any user, who knows the code, join the channel.
+type InviteCode struct {
+	Code      string
+	ChannelID uint64
+	// Role is the role, which users get on joining with the code.
+	Role      Role
+	CreatedBy string
+	CreatedAt time.Time
+	// ExpiresAt is zero for codes, which never expire.
+	ExpiresAt time.Time
+	// MaxUses is zero for codes, which can be used any number of times.
+	MaxUses uint32
+	Uses    uint32
+	// Redemptions are the users, who have joined the channel with the code,
+	// in the order they have redeemed it.
+	Redemptions []InviteCodeRedemption
+}
+
+// InviteCodeRedemption is the user joining the channel with the invite code.
+type InviteCodeRedemption struct {
+	Username   string
+	RedeemedAt time.Time
+}
+
+// newInviteCode returns a new random code, which is safe to be used in URLs.
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// usable checks that the code hasn't expired and hasn't been used up.
+func (r *InviteCodeRecord) usable() error {
+	if !r.ExpiresAt.IsZero() && !time.Now().Before(r.ExpiresAt) {
+		return fmt.Errorf("invite code has expired")
+	}
+	if r.MaxUses != 0 && r.Uses >= r.MaxUses {
+		return fmt.Errorf("invite code has been used up")
+	}
+	return nil
+}
+
+// getMembershipRole returns the role requested for a new member of the
+// channel, which is MemberRole if no role is set.
+func getMembershipRole(role pb.Role, customRoleID int32) Role {
+	if r := getRoleFromPB(role, customRoleID); r != UnknownRole {
+		return r
+	}
+	return MemberRole
+}
+
+// saveMembershipRequest adds or replaces the pending invite or request to
+// join of the user.
+func (ch *ServerChannel) saveMembershipRequest(record *MembershipRequestRecord) error {
+	if err := ch.storage.SaveMembershipRequest(ch.channelId, record); err != nil {
+		return fmt.Errorf("cannot save membership request of user %s in channel %d: %w", record.Username, ch.channelId, err)
+	}
+
+	ch.pending[record.Username] = record
+	return nil
+}
+
+// removeMembershipRequest removes the pending invite or request to join of the user.
+func (ch *ServerChannel) removeMembershipRequest(username string) error {
+	if err := ch.storage.RemoveMembershipRequest(ch.channelId, username); err != nil {
+		return fmt.Errorf("cannot remove membership request of user %s from channel %d: %w", username, ch.channelId, err)
+	}
+
+	delete(ch.pending, username)
+	return nil
+}
+
+// join makes the user, who has been invited or has requested to join, a member
+// of the channel, and lets the users streaming with the channel know about it.
+// Users with custom roles, which have been removed since, become members.
+func (ch *ServerChannel) join(user *User, role Role) error {
+	if !ch.roleExists(role) {
+		role = MemberRole
+	}
+	if err := ch.addUser(&channelUser{user: user, role: role}); err != nil {
+		return err
+	}
+	if err := ch.removeMembershipRequest(user.username); err != nil {
+		return err
+	}
+
+	pbRole, customRoleID := getPBRole(role)
+	ch.broadcast(&pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: &pb.ChannelConfigMessage_RoleMsg{
+					RoleMsg: &pb.ChannelConfigMessage_RoleChannelConfigMessage{
+						Username:     user.username,
+						Role:         pbRole,
+						CustomRoleId: customRoleID,
+					},
+				},
+			},
+		},
+	})
+	return nil
+}
+
+// invite returns the pending invite of the user, or nil if the user hasn't
+// been invited.
+func (ch *ServerChannel) invite(username string) *MembershipRequestRecord {
+	if record, ok := ch.pending[username]; ok && record.InvitedBy != "" {
+		return record
+	}
+	return nil
+}
+
+// joinRequest returns the pending request of the user to join, or nil if the
+// user hasn't requested to join.
+func (ch *ServerChannel) joinRequest(username string) *MembershipRequestRecord {
+	if record, ok := ch.pending[username]; ok && record.InvitedBy == "" {
+		return record
+	}
+	return nil
+}
+
+// pendingMembers returns pending invites and requests to join the channel,
+// each sorted by usernames.
+func (ch *ServerChannel) pendingMembers() ([]*pb.Invite, []*pb.JoinRequest, error) {
+	usernames := make([]string, 0, len(ch.pending))
+	for username := range ch.pending {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+
+	var invites []*pb.Invite
+	var joinRequests []*pb.JoinRequest
+	for _, username := range usernames {
+		record := ch.pending[username]
+		if record.InvitedBy != "" {
+			invite, err := getPBInvite(ch, record)
+			if err != nil {
+				return nil, nil, err
+			}
+			invites = append(invites, invite)
+			continue
+		}
+		joinRequest, err := getPBJoinRequest(record)
+		if err != nil {
+			return nil, nil, err
+		}
+		joinRequests = append(joinRequests, joinRequest)
+	}
+	return invites, joinRequests, nil
+}
+
+// saveInviteCode adds or replaces the invite code of the channel.
+func (ch *ServerChannel) saveInviteCode(record *InviteCodeRecord) error {
+	if err := ch.storage.SaveInviteCode(ch.channelId, record); err != nil {
+		return fmt.Errorf("cannot save invite code of channel %d: %w", ch.channelId, err)
+	}
+
+	ch.inviteCodes[record.Code] = record
+	return nil
+}
+
+// broadcastRedemption notifies users, who can see invite codes of the
+// channel, that the user has joined it with the invite code.
+func (ch *ServerChannel) broadcastRedemption(code string, redemption *InviteCodeRedemptionRecord) error {
+	redeemedAt, err := getPBTimestamp(redemption.RedeemedAt)
+	if err != nil {
+		return err
+	}
+	ch.broadcastIf(&pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: &pb.ChannelConfigMessage_InviteCodeRedeemedMsg{
+					InviteCodeRedeemedMsg: &pb.ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage{
+						Code:       code,
+						Username:   redemption.Username,
+						RedeemedAt: redeemedAt,
+					},
+				},
+			},
+		},
+	}, func(username string) bool {
+		// like GetInviteCodes
+		return ch.hasPermission(username, AssignRolePermission)
+	})
+	return nil
+}
+
+// removeInviteCode revokes the invite code of the channel.
+func (ch *ServerChannel) removeInviteCode(code string) error {
+	if err := ch.storage.RemoveInviteCode(ch.channelId, code); err != nil {
+		return fmt.Errorf("cannot remove invite code of channel %d: %w", ch.channelId, err)
+	}
+
+	delete(ch.inviteCodes, code)
+	return nil
+}
+
+// sortedInviteCodes returns all invite codes of the channel sorted by the
+// time they were created.
+func (ch *ServerChannel) sortedInviteCodes() ([]*pb.InviteCode, error) {
+	records := make([]*InviteCodeRecord, 0, len(ch.inviteCodes))
+	for _, record := range ch.inviteCodes {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].CreatedAt.Equal(records[j].CreatedAt) {
+			return records[i].CreatedAt.Before(records[j].CreatedAt)
+		}
+		return records[i].Code < records[j].Code
+	})
+
+	codes := make([]*pb.InviteCode, len(records))
+	for i, record := range records {
+		code, err := getPBInviteCode(ch.channelId, record)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+	}
+	return codes, nil
+}
+
+func getPBInvite(ch *ServerChannel, record *MembershipRequestRecord) (*pb.Invite, error) {
+	invitedAt, err := getPBTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	role, customRoleID := getPBRole(record.Role)
+	return &pb.Invite{
+		ChannelId:    ch.channelId,
+		ChannelName:  ch.name,
+		Username:     record.Username,
+		Role:         role,
+		CustomRoleId: customRoleID,
+		InvitedBy:    record.InvitedBy,
+		InvitedAt:    invitedAt,
+	}, nil
+}
+
+func getPBJoinRequest(record *MembershipRequestRecord) (*pb.JoinRequest, error) {
+	requestedAt, err := getPBTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	role, customRoleID := getPBRole(record.Role)
+	return &pb.JoinRequest{
+		Username:     record.Username,
+		Role:         role,
+		CustomRoleId: customRoleID,
+		RequestedAt:  requestedAt,
+	}, nil
+}
+
+func getInvite(m *pb.Invite) Invite {
+	return Invite{
+		ChannelID:   m.GetChannelId(),
+		ChannelName: m.GetChannelName(),
+		Username:    m.GetUsername(),
+		Role:        getRoleFromPB(m.GetRole(), m.GetCustomRoleId()),
+		InvitedBy:   m.GetInvitedBy(),
+		InvitedAt:   getTime(m.GetInvitedAt()),
+	}
+}
+
+func getJoinRequest(m *pb.JoinRequest) JoinRequest {
+	return JoinRequest{
+		Username:    m.GetUsername(),
+		Role:        getRoleFromPB(m.GetRole(), m.GetCustomRoleId()),
+		RequestedAt: getTime(m.GetRequestedAt()),
+	}
+}
+
+func getPBInviteCode(channelID uint64, record *InviteCodeRecord) (*pb.InviteCode, error) {
+	createdAt, err := getPBTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := getPBTimestamp(record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	redemptions := make([]*pb.InviteCode_Redemption, len(record.Redemptions))
+	for i, redemption := range record.Redemptions {
+		redeemedAt, err := getPBTimestamp(redemption.RedeemedAt)
+		if err != nil {
+			return nil, err
+		}
+		redemptions[i] = &pb.InviteCode_Redemption{
+			Username:   redemption.Username,
+			RedeemedAt: redeemedAt,
+		}
+	}
+	role, customRoleID := getPBRole(record.Role)
+	return &pb.InviteCode{
+		Code:         record.Code,
+		ChannelId:    channelID,
+		Role:         role,
+		CustomRoleId: customRoleID,
+		CreatedBy:    record.CreatedBy,
+		CreatedAt:    createdAt,
+		ExpiresAt:    expiresAt,
+		MaxUses:      record.MaxUses,
+		Uses:         record.Uses,
+		Redemptions:  redemptions,
+	}, nil
+}
+
+func getInviteCode(m *pb.InviteCode) InviteCode {
+	var redemptions []InviteCodeRedemption
+	for _, redemption := range m.GetRedemptions() {
+		redemptions = append(redemptions, InviteCodeRedemption{
+			Username:   redemption.GetUsername(),
+			RedeemedAt: getTime(redemption.GetRedeemedAt()),
+		})
+	}
+	return InviteCode{
+		Code:        m.GetCode(),
+		ChannelID:   m.GetChannelId(),
+		Role:        getRoleFromPB(m.GetRole(), m.GetCustomRoleId()),
+		CreatedBy:   m.GetCreatedBy(),
+		CreatedAt:   getTime(m.GetCreatedAt()),
+		ExpiresAt:   getTime(m.GetExpiresAt()),
+		MaxUses:     m.GetMaxUses(),
+		Uses:        m.GetUses(),
+		Redemptions: redemptions,
+	}
+}
@@ -0,0 +1,427 @@
+package accord
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MemoryStorage is a Storage which keeps everything in memory. It is suitable
+// for tests and for servers which don't need to survive restarts.
+type MemoryStorage struct {
+	mutex        sync.RWMutex
+	users        map[string]UserRecord
+	channels     map[uint64]ChannelRecord
+	channelUsers map[uint64]map[string]ChannelUserRecord
+	// messages of the channel are ordered by their Ids, which start from 1.
+	messages map[uint64][]MessageRecord
+	bans     map[uint64]map[string]BanRecord
+	// membershipRequests are pending invites and requests to join channels
+	membershipRequests map[uint64]map[string]MembershipRequestRecord
+	inviteCodes        map[uint64]map[string]InviteCodeRecord
+	revokedTokens      map[string]RevokedTokenRecord
+	userRevocations    map[string]UserRevocationRecord
+	// nextChannelID is the Id, which will be returned by NextChannelID
+	nextChannelID uint64
+}
+
+// NewMemoryStorage returns a new empty in-memory storage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		users:              make(map[string]UserRecord),
+		channels:           make(map[uint64]ChannelRecord),
+		channelUsers:       make(map[uint64]map[string]ChannelUserRecord),
+		messages:           make(map[uint64][]MessageRecord),
+		bans:               make(map[uint64]map[string]BanRecord),
+		membershipRequests: make(map[uint64]map[string]MembershipRequestRecord),
+		inviteCodes:        make(map[uint64]map[string]InviteCodeRecord),
+		revokedTokens:      make(map[string]RevokedTokenRecord),
+		userRevocations:    make(map[string]UserRevocationRecord),
+	}
+}
+
+func (s *MemoryStorage) SaveUser(user *UserRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.users[user.Username] = *user
+	return nil
+}
+
+func (s *MemoryStorage) Users() ([]*UserRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	users := make([]*UserRecord, 0, len(s.users))
+	for _, user := range s.users {
+		user := user
+		users = append(users, &user)
+	}
+	return users, nil
+}
+
+func (s *MemoryStorage) NextChannelID() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextChannelID
+	s.nextChannelID++
+	return id, nil
+}
+
+func (s *MemoryStorage) SaveChannel(channel *ChannelRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	record := *channel
+	record.RolesWithPermission = cloneRolesWithPermission(channel.RolesWithPermission)
+	record.CustomRoles = cloneCustomRoles(channel.CustomRoles)
+	s.channels[channel.ChannelID] = record
+	if _, ok := s.channelUsers[channel.ChannelID]; !ok {
+		s.channelUsers[channel.ChannelID] = make(map[string]ChannelUserRecord)
+		s.bans[channel.ChannelID] = make(map[string]BanRecord)
+		s.membershipRequests[channel.ChannelID] = make(map[string]MembershipRequestRecord)
+		s.inviteCodes[channel.ChannelID] = make(map[string]InviteCodeRecord)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) RemoveChannel(channelID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.channels, channelID)
+	delete(s.channelUsers, channelID)
+	delete(s.messages, channelID)
+	delete(s.bans, channelID)
+	delete(s.membershipRequests, channelID)
+	delete(s.inviteCodes, channelID)
+	return nil
+}
+
+func (s *MemoryStorage) Channels() ([]*ChannelRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	channels := make([]*ChannelRecord, 0, len(s.channels))
+	for _, channel := range s.channels {
+		channel := channel
+		channel.RolesWithPermission = cloneRolesWithPermission(channel.RolesWithPermission)
+		channel.CustomRoles = cloneCustomRoles(channel.CustomRoles)
+		channels = append(channels, &channel)
+	}
+	return channels, nil
+}
+
+func (s *MemoryStorage) SaveChannelUser(channelID uint64, user *ChannelUserRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	users, ok := s.channelUsers[channelID]
+	if !ok {
+		return fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	users[user.Username] = *user
+	return nil
+}
+
+func (s *MemoryStorage) RemoveChannelUser(channelID uint64, username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if users, ok := s.channelUsers[channelID]; ok {
+		delete(users, username)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) ChannelUsers(channelID uint64) ([]*ChannelUserRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	users, ok := s.channelUsers[channelID]
+	if !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	records := make([]*ChannelUserRecord, 0, len(users))
+	for _, user := range users {
+		user := user
+		records = append(records, &user)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) AppendMessage(channelID uint64, msg *MessageRecord) (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.channels[channelID]; !ok {
+		return 0, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	record := cloneMessageRecord(msg)
+	record.MessageID = uint64(len(s.messages[channelID])) + 1
+	s.messages[channelID] = append(s.messages[channelID], record)
+	return record.MessageID, nil
+}
+
+func (s *MemoryStorage) UpdateMessage(channelID uint64, msg *MessageRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	messages := s.messages[channelID]
+	if msg.MessageID == 0 || msg.MessageID > uint64(len(messages)) {
+		return fmt.Errorf("message with id %d doesn't exist in channel %d", msg.MessageID, channelID)
+	}
+	messages[msg.MessageID-1] = cloneMessageRecord(msg)
+	return nil
+}
+
+func (s *MemoryStorage) Message(channelID uint64, messageID uint64) (*MessageRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	messages := s.messages[channelID]
+	if messageID == 0 || messageID > uint64(len(messages)) {
+		return nil, fmt.Errorf("message with id %d doesn't exist in channel %d", messageID, channelID)
+	}
+	record := cloneMessageRecord(&messages[messageID-1])
+	return &record, nil
+}
+
+func (s *MemoryStorage) Messages(channelID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, ok := s.channels[channelID]; !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	messages := s.messages[channelID]
+	if beforeID == 0 || beforeID > uint64(len(messages)) {
+		beforeID = uint64(len(messages)) + 1
+	}
+
+	var records []*MessageRecord
+	for i := 0; i < len(messages) && len(records) < limit; i++ {
+		// Ids start from 1, so Id of the message is its index + 1
+		id := afterID + 1 + uint64(i)
+		if newest {
+			id = beforeID - 1 - uint64(i)
+		}
+		if id <= afterID || id >= beforeID {
+			break
+		}
+		if msg := cloneMessageRecord(&messages[id-1]); !msg.Deleted {
+			records = append(records, &msg)
+		}
+	}
+	if newest {
+		reverseMessageRecords(records)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) ThreadMessages(channelID uint64, threadRootID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, ok := s.channels[channelID]; !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	messages := s.messages[channelID]
+	// replies are always newer than the root of their thread
+	if afterID < threadRootID {
+		afterID = threadRootID
+	}
+	if beforeID == 0 || beforeID > uint64(len(messages)) {
+		beforeID = uint64(len(messages)) + 1
+	}
+
+	var records []*MessageRecord
+	for i := uint64(0); afterID+1+i < beforeID && len(records) < limit; i++ {
+		id := afterID + 1 + i
+		if newest {
+			id = beforeID - 1 - i
+		}
+		if msg := &messages[id-1]; msg.ThreadRootID == threadRootID && !msg.Deleted {
+			record := cloneMessageRecord(msg)
+			records = append(records, &record)
+		}
+	}
+	if newest {
+		reverseMessageRecords(records)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) SaveBan(channelID uint64, ban *BanRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bans, ok := s.bans[channelID]
+	if !ok {
+		return fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	bans[ban.Username] = *ban
+	return nil
+}
+
+func (s *MemoryStorage) RemoveBan(channelID uint64, username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if bans, ok := s.bans[channelID]; ok {
+		delete(bans, username)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) Bans(channelID uint64) ([]*BanRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	bans, ok := s.bans[channelID]
+	if !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	records := make([]*BanRecord, 0, len(bans))
+	for _, ban := range bans {
+		ban := ban
+		records = append(records, &ban)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) SaveMembershipRequest(channelID uint64, request *MembershipRequestRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	requests, ok := s.membershipRequests[channelID]
+	if !ok {
+		return fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	requests[request.Username] = *request
+	return nil
+}
+
+func (s *MemoryStorage) RemoveMembershipRequest(channelID uint64, username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if requests, ok := s.membershipRequests[channelID]; ok {
+		delete(requests, username)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) MembershipRequests(channelID uint64) ([]*MembershipRequestRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	requests, ok := s.membershipRequests[channelID]
+	if !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	records := make([]*MembershipRequestRecord, 0, len(requests))
+	for _, request := range requests {
+		request := request
+		records = append(records, &request)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) SaveInviteCode(channelID uint64, code *InviteCodeRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	codes, ok := s.inviteCodes[channelID]
+	if !ok {
+		return fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	codes[code.Code] = *code
+	return nil
+}
+
+func (s *MemoryStorage) RemoveInviteCode(channelID uint64, code string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if codes, ok := s.inviteCodes[channelID]; ok {
+		delete(codes, code)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) InviteCodes(channelID uint64) ([]*InviteCodeRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	codes, ok := s.inviteCodes[channelID]
+	if !ok {
+		return nil, fmt.Errorf("channel with id %d doesn't exist", channelID)
+	}
+	records := make([]*InviteCodeRecord, 0, len(codes))
+	for _, code := range codes {
+		code := code
+		records = append(records, &code)
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) SaveRevokedToken(token *RevokedTokenRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.revokedTokens[token.ID] = *token
+	return nil
+}
+
+func (s *MemoryStorage) RemoveRevokedToken(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.revokedTokens, id)
+	return nil
+}
+
+func (s *MemoryStorage) RevokedTokens() ([]*RevokedTokenRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	tokens := make([]*RevokedTokenRecord, 0, len(s.revokedTokens))
+	for _, token := range s.revokedTokens {
+		token := token
+		tokens = append(tokens, &token)
+	}
+	return tokens, nil
+}
+
+func (s *MemoryStorage) SaveUserRevocation(revocation *UserRevocationRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.userRevocations[revocation.Username] = *revocation
+	return nil
+}
+
+func (s *MemoryStorage) RemoveUserRevocation(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.userRevocations, username)
+	return nil
+}
+
+func (s *MemoryStorage) UserRevocations() ([]*UserRevocationRecord, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	revocations := make([]*UserRevocationRecord, 0, len(s.userRevocations))
+	for _, revocation := range s.userRevocations {
+		revocation := revocation
+		revocations = append(revocations, &revocation)
+	}
+	return revocations, nil
+}
+
+func (s *MemoryStorage) Close() error {
+	return nil
+}
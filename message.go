@@ -2,14 +2,46 @@ package accord
 
 import (
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Message is a single message in the chat
 type Message struct {
 	MessageID uint64
 	Timestamp time.Time
-	// TODO: add "Sender" field, denoting the user, who sent the message.
+	// Sender is the username of the user, who sent the message.
+	Sender  string
 	Content string
+	// ReplyTo is the message, which this message replies to, and ThreadRootID
+	// is the first message of their thread. Both are zero unless it is a reply.
+	ReplyTo      uint64
+	ThreadRootID uint64
+	// ReplyCount and LastReplyAt describe the thread started by this message.
+	ReplyCount  uint32
+	LastReplyAt time.Time
+	Reactions   []Reaction
+	// SeenBy contains members other than the sender, who have read the
+	// message. It is only fetched on request.
+	SeenBy []string
+}
+
+// Reaction contains users, who have reacted to the message with the emoji.
+type Reaction struct {
+	Emoji     string
+	Count     uint32
+	Usernames []string
+}
+
+// Thread is a message, which has been replied to, and the replies to it or to
+// other replies in the thread.
+type Thread struct {
+	RootID      uint64
+	ReplyCount  uint32
+	LastReplyAt time.Time
+	// Replies are the fetched replies sorted by their Ids.
+	Replies []Message
 }
 
 // ChannelConfigMessage is used in ChannelStreamRequest- and Response
@@ -54,6 +86,41 @@ func (m *ChannelConfigMessage) getPinMsg() *PinChannelConfigMessage {
 	return nil
 }
 
+func (m *ChannelConfigMessage) getDefineRoleMsg() *DefineRoleChannelConfigMessage {
+	if x, ok := m.getMsg().(*DefineRoleChannelConfigMessage); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *ChannelConfigMessage) getRemoveRoleMsg() *RemoveRoleChannelConfigMessage {
+	if x, ok := m.getMsg().(*RemoveRoleChannelConfigMessage); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *ChannelConfigMessage) getKickMsg() *KickChannelConfigMessage {
+	if x, ok := m.getMsg().(*KickChannelConfigMessage); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *ChannelConfigMessage) getBanMsg() *BanChannelConfigMessage {
+	if x, ok := m.getMsg().(*BanChannelConfigMessage); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *ChannelConfigMessage) getUnbanMsg() *UnbanChannelConfigMessage {
+	if x, ok := m.getMsg().(*UnbanChannelConfigMessage); ok {
+		return x
+	}
+	return nil
+}
+
 type NameChannelConfigMessage struct {
 	NewChannelName string
 }
@@ -73,6 +140,134 @@ type PinChannelConfigMessage struct {
 
 func (*PinChannelConfigMessage) isChannelConfigMessageMsg() {}
 
+// RoleDefinition describes a role within a single channel and its permissions.
+type RoleDefinition struct {
+	Role        Role
+	Name        string
+	Permissions []Permission
+}
+
+// DefineRoleChannelConfigMessage creates a new custom role if Role of the
+// definition is UnknownRole, or redefines the existing custom role otherwise.
+type DefineRoleChannelConfigMessage struct {
+	Definition RoleDefinition
+}
+
+func (*DefineRoleChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// RemoveRoleChannelConfigMessage removes the custom role, users who had it
+// become members.
+type RemoveRoleChannelConfigMessage struct {
+	Role Role
+}
+
+func (*RemoveRoleChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// Ban prevents the user from joining the channel until it expires.
+type Ban struct {
+	Username string
+	Reason   string
+	// BannedBy and BannedAt are set by the server.
+	BannedBy string
+	BannedAt time.Time
+	// ExpiresAt is zero for permanent bans.
+	ExpiresAt time.Time
+}
+
+// KickChannelConfigMessage removes the user from the channel and closes
+// the user's stream. The user can join the channel again.
+type KickChannelConfigMessage struct {
+	Username string
+}
+
+func (*KickChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// BanChannelConfigMessage removes the user from the channel, closes the
+// user's stream and prevents the user from joining until the ban expires.
+type BanChannelConfigMessage struct {
+	Ban Ban
+}
+
+func (*BanChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// UnbanChannelConfigMessage lifts the ban of the user.
+type UnbanChannelConfigMessage struct {
+	Username string
+}
+
+func (*UnbanChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// InviteCodeRedeemedChannelConfigMessage is sent by the server, when the user
+// joins the channel with the invite code, only to users, who can see invite
+// codes of the channel. Clients cannot send it.
+type InviteCodeRedeemedChannelConfigMessage struct {
+	Code       string
+	Username   string
+	RedeemedAt time.Time
+}
+
+func (*InviteCodeRedeemedChannelConfigMessage) isChannelConfigMessageMsg() {}
+
+// EphemeralMessage is used in ChannelStreamRequest- and Response for events,
+// which are only delivered to other users streaming with the channel at the
+// moment and are never stored.
+type EphemeralMessage struct {
+	// Username is the user, who caused the event. It is set by the server.
+	Username string
+	Msg      isEphemeralMessageMsg
+}
+
+type isEphemeralMessageMsg interface {
+	isEphemeralMessageMsg()
+}
+
+func (*EphemeralMessage) isChannelStreamRequestMsg() {}
+
+func (*EphemeralMessage) isChannelStreamResponseMsg() {}
+
+func (m *EphemeralMessage) getMsg() isEphemeralMessageMsg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// GetTypingMsg returns the typing signal if the event is one.
+func (m *EphemeralMessage) GetTypingMsg() *TypingEphemeralMessage {
+	if x, ok := m.getMsg().(*TypingEphemeralMessage); ok {
+		return x
+	}
+	return nil
+}
+
+// GetPresenceMsg returns the presence change if the event is one.
+func (m *EphemeralMessage) GetPresenceMsg() *PresenceEphemeralMessage {
+	if x, ok := m.getMsg().(*PresenceEphemeralMessage); ok {
+		return x
+	}
+	return nil
+}
+
+// TypingEphemeralMessage is sent repeatedly while the user is typing. The
+// user should be considered to have stopped typing at ExpiresAt, unless the
+// signal is received again.
+type TypingEphemeralMessage struct {
+	// Stopped is set if the user has stopped typing before the signal expired.
+	Stopped bool
+	// ExpiresAt is set by the server.
+	ExpiresAt time.Time
+}
+
+func (*TypingEphemeralMessage) isEphemeralMessageMsg() {}
+
+// PresenceEphemeralMessage is sent by the server to members of channels, which
+// the user is in, when the user's presence changes.
+type PresenceEphemeralMessage struct {
+	Presence Presence
+}
+
+func (*PresenceEphemeralMessage) isEphemeralMessageMsg() {}
+
 // ChannelStreamRequestType is a type of channel stream request message.
 type ChannelStreamRequestType int
 
@@ -83,6 +278,9 @@ const (
 	// ChannelConfigChannelStreamRequestType carries channel configuration changes,
 	// including name change, updating user roles, and specifying pinned message.
 	ChannelConfigChannelStreamRequestType
+	// EphemeralChannelStreamRequestType carries events, which are not stored,
+	// such as typing signals.
+	EphemeralChannelStreamRequestType
 )
 
 // ChannelStreamRequest represents a stream request for a single channel.
@@ -123,6 +321,13 @@ func (x *ChannelStreamRequest) GetConfMsg() *ChannelConfigMessage {
 	return nil
 }
 
+func (x *ChannelStreamRequest) GetEphemeralMsg() *EphemeralMessage {
+	if x, ok := x.GetMsg().(*EphemeralMessage); ok {
+		return x
+	}
+	return nil
+}
+
 // ChannelStreamResponseType is a type of channel stream response message.
 type ChannelStreamResponseType int
 
@@ -133,6 +338,15 @@ const (
 	// ChannelConfigChannelStreamResponseType carries channel configuration changes,
 	// including name change, updating user roles, and specifying pinned message.
 	ChannelConfigChannelStreamResponseType
+	// EphemeralChannelStreamResponseType carries events, which are not stored,
+	// such as typing signals.
+	EphemeralChannelStreamResponseType
+	// ChannelRemovedChannelStreamResponseType is the last response of the
+	// stream, which is closed since the channel has been removed.
+	ChannelRemovedChannelStreamResponseType
+	// ErrorChannelStreamResponseType reports the rejected or failed request
+	// to the user, who has made it.
+	ErrorChannelStreamResponseType
 )
 
 type ChannelStreamResponse struct {
@@ -143,6 +357,28 @@ type isChannelStreamResponseMsg interface {
 	isChannelStreamResponseMsg()
 }
 
+// ChannelRemovedMessage is sent to all users streaming with the channel,
+// when it is removed, right before their streams are closed.
+type ChannelRemovedMessage struct {
+	RemovedBy string
+}
+
+func (*ChannelRemovedMessage) isChannelStreamResponseMsg() {}
+
+// ErrorMessage is sent only to the user, whose request has been rejected or
+// has failed. The stream stays open.
+type ErrorMessage struct {
+	Code    codes.Code
+	Message string
+}
+
+func (*ErrorMessage) isChannelStreamResponseMsg() {}
+
+// Err returns the error as a gRPC status error.
+func (m *ErrorMessage) Err() error {
+	return status.Error(m.Code, m.Message)
+}
+
 // UserChannelStreamRequest is a stream message sent by one of the users to the channel.
 type UserChannelStreamRequest struct {
 	UserMsg isUserChannelStreamRequestUserMsg
@@ -156,6 +392,8 @@ func (*UserChannelStreamRequest) isChannelStreamRequestMsg() {}
 
 type NewMessageUserChannelStreamRequest struct {
 	Content string
+	// ReplyTo is the Id of the message to reply to, or zero.
+	ReplyTo uint64
 }
 
 func (*NewMessageUserChannelStreamRequest) isUserChannelStreamRequestUserMsg() {}
@@ -173,6 +411,30 @@ type DeleteMessageUserChannelStreamRequest struct {
 
 func (*DeleteMessageUserChannelStreamRequest) isUserChannelStreamRequestUserMsg() {}
 
+// AddReactionUserChannelStreamRequest adds the reaction of the user to the
+// message, unless the user has already reacted with the same emoji.
+type AddReactionUserChannelStreamRequest struct {
+	MessageID uint64
+	Emoji     string
+}
+
+func (*AddReactionUserChannelStreamRequest) isUserChannelStreamRequestUserMsg() {}
+
+type RemoveReactionUserChannelStreamRequest struct {
+	MessageID uint64
+	Emoji     string
+}
+
+func (*RemoveReactionUserChannelStreamRequest) isUserChannelStreamRequestUserMsg() {}
+
+// MarkReadUserChannelStreamRequest marks all the messages up to the message as
+// read by the user. The last read message never moves back.
+type MarkReadUserChannelStreamRequest struct {
+	MessageID uint64
+}
+
+func (*MarkReadUserChannelStreamRequest) isUserChannelStreamRequestUserMsg() {}
+
 func (m *UserChannelStreamRequest) getUserMsg() isUserChannelStreamRequestUserMsg {
 	if m != nil {
 		return m.UserMsg
@@ -201,10 +463,36 @@ func (m *UserChannelStreamRequest) getDeleteUserMsg() *DeleteMessageUserChannelS
 	return nil
 }
 
+func (m *UserChannelStreamRequest) getAddReactionMsg() *AddReactionUserChannelStreamRequest {
+	if x, ok := m.getUserMsg().(*AddReactionUserChannelStreamRequest); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *UserChannelStreamRequest) getRemoveReactionMsg() *RemoveReactionUserChannelStreamRequest {
+	if x, ok := m.getUserMsg().(*RemoveReactionUserChannelStreamRequest); ok {
+		return x
+	}
+	return nil
+}
+
+func (m *UserChannelStreamRequest) getMarkReadMsg() *MarkReadUserChannelStreamRequest {
+	if x, ok := m.getUserMsg().(*MarkReadUserChannelStreamRequest); ok {
+		return x
+	}
+	return nil
+}
+
 // UserChannelStreamResponse is a stream message broadcasted to all users in the channel.
 type UserChannelStreamResponse struct {
 	MessageID uint64
-	UserMsg   isUserChannelStreamResponseUserMsg
+	// Sender is the username of the author of the message.
+	Sender string
+	// ReplyTo and ThreadRootID are set for replies.
+	ReplyTo      uint64
+	ThreadRootID uint64
+	UserMsg      isUserChannelStreamResponseUserMsg
 }
 
 type isUserChannelStreamResponseUserMsg interface {
@@ -216,6 +504,9 @@ func (*UserChannelStreamResponse) isChannelStreamResponseMsg() {}
 type NewAndUpdateMessageUserChannelStreamResponse struct {
 	Timestamp time.Time
 	Content   string
+	// ReplyCount and LastReplyAt describe the thread started by the message.
+	ReplyCount  uint32
+	LastReplyAt time.Time
 }
 
 func (*NewAndUpdateMessageUserChannelStreamResponse) isUserChannelStreamResponseUserMsg() {}
@@ -224,6 +515,22 @@ type DeleteMessageUserChannelStreamResponse struct{}
 
 func (*DeleteMessageUserChannelStreamResponse) isUserChannelStreamResponseUserMsg() {}
 
+// ReactionsMessageUserChannelStreamResponse contains all reactions to the
+// message after some of them have changed.
+type ReactionsMessageUserChannelStreamResponse struct {
+	Reactions []Reaction
+}
+
+func (*ReactionsMessageUserChannelStreamResponse) isUserChannelStreamResponseUserMsg() {}
+
+// ReadMessageUserChannelStreamResponse is sent when the user marks messages
+// as read. MessageID of the response is the last message read by the user.
+type ReadMessageUserChannelStreamResponse struct {
+	Username string
+}
+
+func (*ReadMessageUserChannelStreamResponse) isUserChannelStreamResponseUserMsg() {}
+
 // GetMessageID returns message ID or the user stream response. It returns 0
 // if the user stream response is nil.
 func (m *UserChannelStreamResponse) GetMessageID() uint64 {
@@ -233,6 +540,15 @@ func (m *UserChannelStreamResponse) GetMessageID() uint64 {
 	return 0
 }
 
+// GetSender returns the author of the message. It returns "" if the user
+// stream response is nil.
+func (m *UserChannelStreamResponse) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // GetUserMsg returns the user message contained in the user channel stream response.
 func (m *UserChannelStreamResponse) GetUserMsg() isUserChannelStreamResponseUserMsg {
 	if m != nil {
@@ -256,3 +572,107 @@ func (m *UserChannelStreamResponse) GetDeleteUserMsg() *DeleteMessageUserChannel
 	}
 	return nil
 }
+
+// GetReactionsMsg gets the changed reactions in user response message.
+func (m *UserChannelStreamResponse) GetReactionsMsg() *ReactionsMessageUserChannelStreamResponse {
+	if x, ok := m.GetUserMsg().(*ReactionsMessageUserChannelStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+// GetReadMsg gets the read receipt in user response message.
+func (m *UserChannelStreamResponse) GetReadMsg() *ReadMessageUserChannelStreamResponse {
+	if x, ok := m.GetUserMsg().(*ReadMessageUserChannelStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+// ServerStreamResponse is a server-wide event streamed to the client.
+type ServerStreamResponse struct {
+	Event isServerStreamResponseEvent
+}
+
+type isServerStreamResponseEvent interface {
+	isServerStreamResponseEvent()
+}
+
+// GetEvent returns the event contained in the server stream response.
+func (m *ServerStreamResponse) GetEvent() isServerStreamResponseEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// GetChannelAction returns the channel action if the event is one.
+func (m *ServerStreamResponse) GetChannelAction() *ChannelActionServerStreamResponse {
+	if x, ok := m.GetEvent().(*ChannelActionServerStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+// ChannelActionServerStreamResponse notifies that some channel has been added,
+// removed or renamed.
+type ChannelActionServerStreamResponse struct {
+	ChannelID uint64
+	Action    isChannelActionServerStreamResponseAction
+}
+
+type isChannelActionServerStreamResponseAction interface {
+	isChannelActionServerStreamResponseAction()
+}
+
+func (*ChannelActionServerStreamResponse) isServerStreamResponseEvent() {}
+
+// GetAction returns the action, which has been done to the channel.
+func (m *ChannelActionServerStreamResponse) GetAction() isChannelActionServerStreamResponseAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+// GetAddChannel returns the action if the channel has been added.
+func (m *ChannelActionServerStreamResponse) GetAddChannel() *AddChannelServerStreamResponse {
+	if x, ok := m.GetAction().(*AddChannelServerStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+// GetRemoveChannel returns the action if the channel has been removed.
+func (m *ChannelActionServerStreamResponse) GetRemoveChannel() *RemoveChannelServerStreamResponse {
+	if x, ok := m.GetAction().(*RemoveChannelServerStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+// GetRenameChannel returns the action if the channel has been renamed.
+func (m *ChannelActionServerStreamResponse) GetRenameChannel() *RenameChannelServerStreamResponse {
+	if x, ok := m.GetAction().(*RenameChannelServerStreamResponse); ok {
+		return x
+	}
+	return nil
+}
+
+type AddChannelServerStreamResponse struct {
+	Name     string
+	IsPublic bool
+	IsDirect bool
+}
+
+func (*AddChannelServerStreamResponse) isChannelActionServerStreamResponseAction() {}
+
+type RemoveChannelServerStreamResponse struct{}
+
+func (*RemoveChannelServerStreamResponse) isChannelActionServerStreamResponseAction() {}
+
+type RenameChannelServerStreamResponse struct {
+	NewName string
+}
+
+func (*RenameChannelServerStreamResponse) isChannelActionServerStreamResponseAction() {}
@@ -172,6 +172,261 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_accord_proto_rawDescGZIP(), []int{1}
 }
 
+// Presence of the user across all of the user's connections.
+type PresenceStatus int32
+
+const (
+	// the user has no streams open with the server
+	PresenceStatus_OFFLINE PresenceStatus = 0
+	PresenceStatus_ONLINE  PresenceStatus = 1
+	// the user is connected, but has been marked as away by a client
+	PresenceStatus_IDLE PresenceStatus = 2
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "OFFLINE",
+		1: "ONLINE",
+		2: "IDLE",
+	}
+	PresenceStatus_value = map[string]int32{
+		"OFFLINE": 0,
+		"ONLINE":  1,
+		"IDLE":    2,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_accord_proto_enumTypes[2].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_accord_proto_enumTypes[2]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{2}
+}
+
+type Presence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PresenceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=accord.PresenceStatus" json:"status,omitempty"`
+	// text set by the user, which is kept while the user is offline
+	CustomStatus string `protobuf:"bytes,2,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Presence) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_OFFLINE
+}
+
+func (x *Presence) GetCustomStatus() string {
+	if x != nil {
+		return x.CustomStatus
+	}
+	return ""
+}
+
+// Describes a role within a single channel and its permissions. Ids of
+// custom roles start from 100, smaller Ids are used by built-in roles.
+type RoleDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId      int32        `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=accord.Permission" json:"permissions,omitempty"`
+}
+
+func (x *RoleDefinition) Reset() {
+	*x = RoleDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDefinition) ProtoMessage() {}
+
+func (x *RoleDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDefinition.ProtoReflect.Descriptor instead.
+func (*RoleDefinition) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoleDefinition) GetRoleId() int32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *RoleDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleDefinition) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// A ban of the user in a single channel.
+type Ban struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the user who banned
+	BannedBy string               `protobuf:"bytes,3,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	BannedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`
+	// the ban is permanent if it is not set
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Ban) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Ban) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
+}
+
+func (x *Ban) GetBannedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.BannedAt
+	}
+	return nil
+}
+
+func (x *Ban) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type AddChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -184,7 +439,7 @@ type AddChannelRequest struct {
 func (x *AddChannelRequest) Reset() {
 	*x = AddChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[0]
+		mi := &file_accord_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -197,7 +452,7 @@ func (x *AddChannelRequest) String() string {
 func (*AddChannelRequest) ProtoMessage() {}
 
 func (x *AddChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[0]
+	mi := &file_accord_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +465,7 @@ func (x *AddChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddChannelRequest.ProtoReflect.Descriptor instead.
 func (*AddChannelRequest) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{0}
+	return file_accord_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AddChannelRequest) GetName() string {
@@ -238,7 +493,7 @@ type AddChannelResponse struct {
 func (x *AddChannelResponse) Reset() {
 	*x = AddChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[1]
+		mi := &file_accord_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -251,7 +506,7 @@ func (x *AddChannelResponse) String() string {
 func (*AddChannelResponse) ProtoMessage() {}
 
 func (x *AddChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[1]
+	mi := &file_accord_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +519,7 @@ func (x *AddChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddChannelResponse.ProtoReflect.Descriptor instead.
 func (*AddChannelResponse) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{1}
+	return file_accord_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AddChannelResponse) GetChannelId() uint64 {
@@ -285,7 +540,7 @@ type RemoveChannelRequest struct {
 func (x *RemoveChannelRequest) Reset() {
 	*x = RemoveChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[2]
+		mi := &file_accord_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +553,7 @@ func (x *RemoveChannelRequest) String() string {
 func (*RemoveChannelRequest) ProtoMessage() {}
 
 func (x *RemoveChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[2]
+	mi := &file_accord_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +566,7 @@ func (x *RemoveChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveChannelRequest.ProtoReflect.Descriptor instead.
 func (*RemoveChannelRequest) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{2}
+	return file_accord_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RemoveChannelRequest) GetChannelId() uint64 {
@@ -330,7 +585,7 @@ type RemoveChannelResponse struct {
 func (x *RemoveChannelResponse) Reset() {
 	*x = RemoveChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[3]
+		mi := &file_accord_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +598,7 @@ func (x *RemoveChannelResponse) String() string {
 func (*RemoveChannelResponse) ProtoMessage() {}
 
 func (x *RemoveChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[3]
+	mi := &file_accord_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +611,7 @@ func (x *RemoveChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveChannelResponse.ProtoReflect.Descriptor instead.
 func (*RemoveChannelResponse) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{3}
+	return file_accord_proto_rawDescGZIP(), []int{6}
 }
 
 type GetChannelsRequest struct {
@@ -368,7 +623,7 @@ type GetChannelsRequest struct {
 func (x *GetChannelsRequest) Reset() {
 	*x = GetChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[4]
+		mi := &file_accord_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -381,7 +636,7 @@ func (x *GetChannelsRequest) String() string {
 func (*GetChannelsRequest) ProtoMessage() {}
 
 func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[4]
+	mi := &file_accord_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +649,7 @@ func (x *GetChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChannelsRequest.ProtoReflect.Descriptor instead.
 func (*GetChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{4}
+	return file_accord_proto_rawDescGZIP(), []int{7}
 }
 
 type GetChannelsResponse struct {
@@ -408,7 +663,7 @@ type GetChannelsResponse struct {
 func (x *GetChannelsResponse) Reset() {
 	*x = GetChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[5]
+		mi := &file_accord_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +676,7 @@ func (x *GetChannelsResponse) String() string {
 func (*GetChannelsResponse) ProtoMessage() {}
 
 func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[5]
+	mi := &file_accord_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +689,7 @@ func (x *GetChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChannelsResponse.ProtoReflect.Descriptor instead.
 func (*GetChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{5}
+	return file_accord_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetChannelsResponse) GetChannelMetas() map[uint64]*GetChannelsResponse_ChannelMeta {
@@ -455,7 +710,7 @@ type GetChannelRequest struct {
 func (x *GetChannelRequest) Reset() {
 	*x = GetChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[6]
+		mi := &file_accord_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -468,7 +723,7 @@ func (x *GetChannelRequest) String() string {
 func (*GetChannelRequest) ProtoMessage() {}
 
 func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[6]
+	mi := &file_accord_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +736,7 @@ func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChannelRequest.ProtoReflect.Descriptor instead.
 func (*GetChannelRequest) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{6}
+	return file_accord_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetChannelRequest) GetChannelId() uint64 {
@@ -502,7 +757,7 @@ type GetChannelResponse struct {
 func (x *GetChannelResponse) Reset() {
 	*x = GetChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[7]
+		mi := &file_accord_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +770,7 @@ func (x *GetChannelResponse) String() string {
 func (*GetChannelResponse) ProtoMessage() {}
 
 func (x *GetChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[7]
+	mi := &file_accord_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +783,7 @@ func (x *GetChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChannelResponse.ProtoReflect.Descriptor instead.
 func (*GetChannelResponse) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{7}
+	return file_accord_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetChannelResponse) GetChannel() *GetChannelResponse_ChannelInfo {
@@ -538,37 +793,39 @@ func (x *GetChannelResponse) GetChannel() *GetChannelResponse_ChannelInfo {
 	return nil
 }
 
-// Used in ChannelStreamRequest- and Response to initiate and broadcast
-// channel-related changes.
-type ChannelConfigMessage struct {
+// A pending invite of the user to the channel.
+type Invite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Msg:
-	//	*ChannelConfigMessage_NameMsg
-	//	*ChannelConfigMessage_RoleMsg
-	//	*ChannelConfigMessage_PinMsg
-	Msg isChannelConfigMessage_Msg `protobuf_oneof:"msg"`
+	ChannelId   uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelName string `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Username    string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// the user gets the role on accepting the invite
+	Role         Role                 `protobuf:"varint,4,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	CustomRoleId int32                `protobuf:"varint,5,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
+	InvitedBy    string               `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	InvitedAt    *timestamp.Timestamp `protobuf:"bytes,7,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
 }
 
-func (x *ChannelConfigMessage) Reset() {
-	*x = ChannelConfigMessage{}
+func (x *Invite) Reset() {
+	*x = Invite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[8]
+		mi := &file_accord_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelConfigMessage) String() string {
+func (x *Invite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelConfigMessage) ProtoMessage() {}
+func (*Invite) ProtoMessage() {}
 
-func (x *ChannelConfigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[8]
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,92 +836,90 @@ func (x *ChannelConfigMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelConfigMessage.ProtoReflect.Descriptor instead.
-func (*ChannelConfigMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{11}
 }
 
-func (m *ChannelConfigMessage) GetMsg() isChannelConfigMessage_Msg {
-	if m != nil {
-		return m.Msg
+func (x *Invite) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChannelConfigMessage) GetNameMsg() *ChannelConfigMessage_NameChannelConfigMessage {
-	if x, ok := x.GetMsg().(*ChannelConfigMessage_NameMsg); ok {
-		return x.NameMsg
+func (x *Invite) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelConfigMessage) GetRoleMsg() *ChannelConfigMessage_RoleChannelConfigMessage {
-	if x, ok := x.GetMsg().(*ChannelConfigMessage_RoleMsg); ok {
-		return x.RoleMsg
-	}
-	return nil
-}
-
-func (x *ChannelConfigMessage) GetPinMsg() *ChannelConfigMessage_PinChannelConfigMessage {
-	if x, ok := x.GetMsg().(*ChannelConfigMessage_PinMsg); ok {
-		return x.PinMsg
+func (x *Invite) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type isChannelConfigMessage_Msg interface {
-	isChannelConfigMessage_Msg()
+func (x *Invite) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
 }
 
-type ChannelConfigMessage_NameMsg struct {
-	NameMsg *ChannelConfigMessage_NameChannelConfigMessage `protobuf:"bytes,1,opt,name=name_msg,json=nameMsg,proto3,oneof"`
+func (x *Invite) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
+	}
+	return 0
 }
 
-type ChannelConfigMessage_RoleMsg struct {
-	RoleMsg *ChannelConfigMessage_RoleChannelConfigMessage `protobuf:"bytes,2,opt,name=role_msg,json=roleMsg,proto3,oneof"`
+func (x *Invite) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
 }
 
-type ChannelConfigMessage_PinMsg struct {
-	PinMsg *ChannelConfigMessage_PinChannelConfigMessage `protobuf:"bytes,3,opt,name=pin_msg,json=pinMsg,proto3,oneof"`
+func (x *Invite) GetInvitedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return nil
 }
 
-func (*ChannelConfigMessage_NameMsg) isChannelConfigMessage_Msg() {}
-
-func (*ChannelConfigMessage_RoleMsg) isChannelConfigMessage_Msg() {}
-
-func (*ChannelConfigMessage_PinMsg) isChannelConfigMessage_Msg() {}
-
-// Stream response for bidirectional streaming of user and  config
-// messages with a single channel.
-type ChannelStreamRequest struct {
+// A pending request of the user to join the public channel.
+type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	// Types that are assignable to Msg:
-	//	*ChannelStreamRequest_UserMsg
-	//	*ChannelStreamRequest_ConfigMsg
-	Msg isChannelStreamRequest_Msg `protobuf_oneof:"msg"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// the user gets the role if the request is approved
+	Role         Role                 `protobuf:"varint,2,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	CustomRoleId int32                `protobuf:"varint,3,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
+	RequestedAt  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
 }
 
-func (x *ChannelStreamRequest) Reset() {
-	*x = ChannelStreamRequest{}
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[9]
+		mi := &file_accord_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamRequest) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamRequest) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *ChannelStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[9]
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,85 +930,71 @@ func (x *ChannelStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamRequest.ProtoReflect.Descriptor instead.
-func (*ChannelStreamRequest) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ChannelStreamRequest) GetChannelId() uint64 {
+func (x *JoinRequest) GetUsername() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *ChannelStreamRequest) GetMsg() isChannelStreamRequest_Msg {
-	if m != nil {
-		return m.Msg
+func (x *JoinRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
 	}
-	return nil
+	return Role_UNKNOWN_ROLE
 }
 
-func (x *ChannelStreamRequest) GetUserMsg() *ChannelStreamRequest_UserMessage {
-	if x, ok := x.GetMsg().(*ChannelStreamRequest_UserMsg); ok {
-		return x.UserMsg
+func (x *JoinRequest) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChannelStreamRequest) GetConfigMsg() *ChannelConfigMessage {
-	if x, ok := x.GetMsg().(*ChannelStreamRequest_ConfigMsg); ok {
-		return x.ConfigMsg
+func (x *JoinRequest) GetRequestedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RequestedAt
 	}
 	return nil
 }
 
-type isChannelStreamRequest_Msg interface {
-	isChannelStreamRequest_Msg()
-}
-
-type ChannelStreamRequest_UserMsg struct {
-	UserMsg *ChannelStreamRequest_UserMessage `protobuf:"bytes,2,opt,name=user_msg,json=userMsg,proto3,oneof"`
-}
-
-type ChannelStreamRequest_ConfigMsg struct {
-	ConfigMsg *ChannelConfigMessage `protobuf:"bytes,3,opt,name=config_msg,json=configMsg,proto3,oneof"`
-}
-
-func (*ChannelStreamRequest_UserMsg) isChannelStreamRequest_Msg() {}
-
-func (*ChannelStreamRequest_ConfigMsg) isChannelStreamRequest_Msg() {}
-
-// Stream response for bidirectional streaming of user and  config
-// messages with a single channel.
-type ChannelStreamResponse struct {
+// Invites the user, who is not in the channel yet, to join it with the role,
+// or MEMBER if the role is not set. The invite replaces any pending invite or
+// request to join of the user. Direct channels cannot be joined.
+type InviteUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Msg:
-	//	*ChannelStreamResponse_UserMsg
-	//	*ChannelStreamResponse_ConfigMsg
-	Msg isChannelStreamResponse_Msg `protobuf_oneof:"msg"`
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role      Role   `protobuf:"varint,3,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	// if set, the custom role with this Id is given instead of role.
+	CustomRoleId int32 `protobuf:"varint,4,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
 }
 
-func (x *ChannelStreamResponse) Reset() {
-	*x = ChannelStreamResponse{}
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[10]
+		mi := &file_accord_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamResponse) String() string {
+func (x *InviteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamResponse) ProtoMessage() {}
+func (*InviteUserRequest) ProtoMessage() {}
 
-func (x *ChannelStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[10]
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,75 +1005,62 @@ func (x *ChannelStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamResponse.ProtoReflect.Descriptor instead.
-func (*ChannelStreamResponse) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *ChannelStreamResponse) GetMsg() isChannelStreamResponse_Msg {
-	if m != nil {
-		return m.Msg
+func (x *InviteUserRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChannelStreamResponse) GetUserMsg() *ChannelStreamResponse_UserMessage {
-	if x, ok := x.GetMsg().(*ChannelStreamResponse_UserMsg); ok {
-		return x.UserMsg
+func (x *InviteUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelStreamResponse) GetConfigMsg() *ChannelConfigMessage {
-	if x, ok := x.GetMsg().(*ChannelStreamResponse_ConfigMsg); ok {
-		return x.ConfigMsg
+func (x *InviteUserRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
 	}
-	return nil
-}
-
-type isChannelStreamResponse_Msg interface {
-	isChannelStreamResponse_Msg()
-}
-
-type ChannelStreamResponse_UserMsg struct {
-	UserMsg *ChannelStreamResponse_UserMessage `protobuf:"bytes,1,opt,name=user_msg,json=userMsg,proto3,oneof"`
+	return Role_UNKNOWN_ROLE
 }
 
-type ChannelStreamResponse_ConfigMsg struct {
-	ConfigMsg *ChannelConfigMessage `protobuf:"bytes,2,opt,name=config_msg,json=configMsg,proto3,oneof"`
+func (x *InviteUserRequest) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
+	}
+	return 0
 }
 
-func (*ChannelStreamResponse_UserMsg) isChannelStreamResponse_Msg() {}
-
-func (*ChannelStreamResponse_ConfigMsg) isChannelStreamResponse_Msg() {}
-
-type GetChannelsResponse_ChannelMeta struct {
+type InviteUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsPublic     bool   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	MembersCount int32  `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
 }
 
-func (x *GetChannelsResponse_ChannelMeta) Reset() {
-	*x = GetChannelsResponse_ChannelMeta{}
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[11]
+		mi := &file_accord_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChannelsResponse_ChannelMeta) String() string {
+func (x *InviteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelsResponse_ChannelMeta) ProtoMessage() {}
+func (*InviteUserResponse) ProtoMessage() {}
 
-func (x *GetChannelsResponse_ChannelMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[11]
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,58 +1071,75 @@ func (x *GetChannelsResponse_ChannelMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelsResponse_ChannelMeta.ProtoReflect.Descriptor instead.
-func (*GetChannelsResponse_ChannelMeta) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetChannelsResponse_ChannelMeta) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Requests pending invites of the caller to all channels.
+type GetInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetChannelsResponse_ChannelMeta) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
+func (x *GetInvitesRequest) Reset() {
+	*x = GetInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *GetChannelsResponse_ChannelMeta) GetMembersCount() int32 {
-	if x != nil {
-		return x.MembersCount
+func (x *GetInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesRequest) ProtoMessage() {}
+
+func (x *GetInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type GetChannelResponse_User struct {
+// Deprecated: Use GetInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{15}
+}
+
+type GetInvitesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Role     int32  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	Invites []*Invite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
 }
 
-func (x *GetChannelResponse_User) Reset() {
-	*x = GetChannelResponse_User{}
+func (x *GetInvitesResponse) Reset() {
+	*x = GetInvitesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[13]
+		mi := &file_accord_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChannelResponse_User) String() string {
+func (x *GetInvitesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelResponse_User) ProtoMessage() {}
+func (*GetInvitesResponse) ProtoMessage() {}
 
-func (x *GetChannelResponse_User) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[13]
+func (x *GetInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,54 +1150,45 @@ func (x *GetChannelResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelResponse_User.ProtoReflect.Descriptor instead.
-func (*GetChannelResponse_User) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *GetChannelResponse_User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use GetInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetChannelResponse_User) GetRole() int32 {
+func (x *GetInvitesResponse) GetInvites() []*Invite {
 	if x != nil {
-		return x.Role
+		return x.Invites
 	}
-	return 0
+	return nil
 }
 
-type GetChannelResponse_ChannelInfo struct {
+// Accepts or declines the invite of the caller to the channel.
+type RespondToInviteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId   uint64                              `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name        string                              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Users       map[string]*GetChannelResponse_User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	PinnedMsgId uint64                              `protobuf:"varint,4,opt,name=pinned_msg_id,json=pinnedMsgId,proto3" json:"pinned_msg_id,omitempty"`
-	IsPublic    bool                                `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Accept    bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *GetChannelResponse_ChannelInfo) Reset() {
-	*x = GetChannelResponse_ChannelInfo{}
+func (x *RespondToInviteRequest) Reset() {
+	*x = RespondToInviteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[14]
+		mi := &file_accord_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChannelResponse_ChannelInfo) String() string {
+func (x *RespondToInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelResponse_ChannelInfo) ProtoMessage() {}
+func (*RespondToInviteRequest) ProtoMessage() {}
 
-func (x *GetChannelResponse_ChannelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[14]
+func (x *RespondToInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,71 +1199,48 @@ func (x *GetChannelResponse_ChannelInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelResponse_ChannelInfo.ProtoReflect.Descriptor instead.
-func (*GetChannelResponse_ChannelInfo) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use RespondToInviteRequest.ProtoReflect.Descriptor instead.
+func (*RespondToInviteRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetChannelResponse_ChannelInfo) GetChannelId() uint64 {
+func (x *RespondToInviteRequest) GetChannelId() uint64 {
 	if x != nil {
 		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *GetChannelResponse_ChannelInfo) GetName() string {
+func (x *RespondToInviteRequest) GetAccept() bool {
 	if x != nil {
-		return x.Name
+		return x.Accept
 	}
-	return ""
+	return false
 }
 
-func (x *GetChannelResponse_ChannelInfo) GetUsers() map[string]*GetChannelResponse_User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+type RespondToInviteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetChannelResponse_ChannelInfo) GetPinnedMsgId() uint64 {
-	if x != nil {
-		return x.PinnedMsgId
-	}
-	return 0
-}
-
-func (x *GetChannelResponse_ChannelInfo) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
-	}
-	return false
-}
-
-type ChannelConfigMessage_NameChannelConfigMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NewChannelName string `protobuf:"bytes,1,opt,name=new_channel_name,json=newChannelName,proto3" json:"new_channel_name,omitempty"`
-}
-
-func (x *ChannelConfigMessage_NameChannelConfigMessage) Reset() {
-	*x = ChannelConfigMessage_NameChannelConfigMessage{}
+func (x *RespondToInviteResponse) Reset() {
+	*x = RespondToInviteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[16]
+		mi := &file_accord_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelConfigMessage_NameChannelConfigMessage) String() string {
+func (x *RespondToInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelConfigMessage_NameChannelConfigMessage) ProtoMessage() {}
+func (*RespondToInviteResponse) ProtoMessage() {}
 
-func (x *ChannelConfigMessage_NameChannelConfigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[16]
+func (x *RespondToInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,44 +1251,40 @@ func (x *ChannelConfigMessage_NameChannelConfigMessage) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelConfigMessage_NameChannelConfigMessage.ProtoReflect.Descriptor instead.
-func (*ChannelConfigMessage_NameChannelConfigMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *ChannelConfigMessage_NameChannelConfigMessage) GetNewChannelName() string {
-	if x != nil {
-		return x.NewChannelName
-	}
-	return ""
+// Deprecated: Use RespondToInviteResponse.ProtoReflect.Descriptor instead.
+func (*RespondToInviteResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{18}
 }
 
-type ChannelConfigMessage_RoleChannelConfigMessage struct {
+// Requests to join the public channel with the role, or MEMBER if the role is
+// not set. Private channels can only be joined by invites.
+type RequestToJoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Role     Role   `protobuf:"varint,2,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	ChannelId    uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Role         Role   `protobuf:"varint,2,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	CustomRoleId int32  `protobuf:"varint,3,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
 }
 
-func (x *ChannelConfigMessage_RoleChannelConfigMessage) Reset() {
-	*x = ChannelConfigMessage_RoleChannelConfigMessage{}
+func (x *RequestToJoinRequest) Reset() {
+	*x = RequestToJoinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[17]
+		mi := &file_accord_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelConfigMessage_RoleChannelConfigMessage) String() string {
+func (x *RequestToJoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelConfigMessage_RoleChannelConfigMessage) ProtoMessage() {}
+func (*RequestToJoinRequest) ProtoMessage() {}
 
-func (x *ChannelConfigMessage_RoleChannelConfigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[17]
+func (x *RequestToJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,50 +1295,55 @@ func (x *ChannelConfigMessage_RoleChannelConfigMessage) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelConfigMessage_RoleChannelConfigMessage.ProtoReflect.Descriptor instead.
-func (*ChannelConfigMessage_RoleChannelConfigMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use RequestToJoinRequest.ProtoReflect.Descriptor instead.
+func (*RequestToJoinRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ChannelConfigMessage_RoleChannelConfigMessage) GetUsername() string {
+func (x *RequestToJoinRequest) GetChannelId() uint64 {
 	if x != nil {
-		return x.Username
+		return x.ChannelId
 	}
-	return ""
+	return 0
 }
 
-func (x *ChannelConfigMessage_RoleChannelConfigMessage) GetRole() Role {
+func (x *RequestToJoinRequest) GetRole() Role {
 	if x != nil {
 		return x.Role
 	}
 	return Role_UNKNOWN_ROLE
 }
 
-type ChannelConfigMessage_PinChannelConfigMessage struct {
+func (x *RequestToJoinRequest) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
+	}
+	return 0
+}
+
+type RequestToJoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
-func (x *ChannelConfigMessage_PinChannelConfigMessage) Reset() {
-	*x = ChannelConfigMessage_PinChannelConfigMessage{}
+func (x *RequestToJoinResponse) Reset() {
+	*x = RequestToJoinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[18]
+		mi := &file_accord_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelConfigMessage_PinChannelConfigMessage) String() string {
+func (x *RequestToJoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelConfigMessage_PinChannelConfigMessage) ProtoMessage() {}
+func (*RequestToJoinResponse) ProtoMessage() {}
 
-func (x *ChannelConfigMessage_PinChannelConfigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[18]
+func (x *RequestToJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,47 +1354,39 @@ func (x *ChannelConfigMessage_PinChannelConfigMessage) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelConfigMessage_PinChannelConfigMessage.ProtoReflect.Descriptor instead.
-func (*ChannelConfigMessage_PinChannelConfigMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{8, 2}
-}
-
-func (x *ChannelConfigMessage_PinChannelConfigMessage) GetMessageId() uint64 {
-	if x != nil {
-		return x.MessageId
-	}
-	return 0
+// Deprecated: Use RequestToJoinResponse.ProtoReflect.Descriptor instead.
+func (*RequestToJoinResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{20}
 }
 
-type ChannelStreamRequest_UserMessage struct {
+// Approves or rejects the pending request of the user to join the channel.
+type ReviewJoinRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to UserMsg:
-	//	*ChannelStreamRequest_UserMessage_NewUserMsg
-	//	*ChannelStreamRequest_UserMessage_EditUserMsg
-	//	*ChannelStreamRequest_UserMessage_DeleteUserMsg
-	UserMsg isChannelStreamRequest_UserMessage_UserMsg `protobuf_oneof:"user_msg"`
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Approve   bool   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
 }
 
-func (x *ChannelStreamRequest_UserMessage) Reset() {
-	*x = ChannelStreamRequest_UserMessage{}
+func (x *ReviewJoinRequestRequest) Reset() {
+	*x = ReviewJoinRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[19]
+		mi := &file_accord_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamRequest_UserMessage) String() string {
+func (x *ReviewJoinRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamRequest_UserMessage) ProtoMessage() {}
+func (*ReviewJoinRequestRequest) ProtoMessage() {}
 
-func (x *ChannelStreamRequest_UserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[19]
+func (x *ReviewJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,86 +1397,110 @@ func (x *ChannelStreamRequest_UserMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamRequest_UserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamRequest_UserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ReviewJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReviewJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{21}
 }
 
-func (m *ChannelStreamRequest_UserMessage) GetUserMsg() isChannelStreamRequest_UserMessage_UserMsg {
-	if m != nil {
-		return m.UserMsg
+func (x *ReviewJoinRequestRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChannelStreamRequest_UserMessage) GetNewUserMsg() *ChannelStreamRequest_UserMessage_NewUserMessage {
-	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_NewUserMsg); ok {
-		return x.NewUserMsg
+func (x *ReviewJoinRequestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelStreamRequest_UserMessage) GetEditUserMsg() *ChannelStreamRequest_UserMessage_EditUserMessage {
-	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_EditUserMsg); ok {
-		return x.EditUserMsg
+func (x *ReviewJoinRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
 	}
-	return nil
+	return false
 }
 
-func (x *ChannelStreamRequest_UserMessage) GetDeleteUserMsg() *ChannelStreamRequest_UserMessage_DeleteUserMessage {
-	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_DeleteUserMsg); ok {
-		return x.DeleteUserMsg
-	}
-	return nil
+type ReviewJoinRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type isChannelStreamRequest_UserMessage_UserMsg interface {
-	isChannelStreamRequest_UserMessage_UserMsg()
+func (x *ReviewJoinRequestResponse) Reset() {
+	*x = ReviewJoinRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ChannelStreamRequest_UserMessage_NewUserMsg struct {
-	NewUserMsg *ChannelStreamRequest_UserMessage_NewUserMessage `protobuf:"bytes,1,opt,name=new_user_msg,json=newUserMsg,proto3,oneof"`
+func (x *ReviewJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ChannelStreamRequest_UserMessage_EditUserMsg struct {
-	EditUserMsg *ChannelStreamRequest_UserMessage_EditUserMessage `protobuf:"bytes,2,opt,name=edit_user_msg,json=editUserMsg,proto3,oneof"`
-}
+func (*ReviewJoinRequestResponse) ProtoMessage() {}
 
-type ChannelStreamRequest_UserMessage_DeleteUserMsg struct {
-	DeleteUserMsg *ChannelStreamRequest_UserMessage_DeleteUserMessage `protobuf:"bytes,3,opt,name=delete_user_msg,json=deleteUserMsg,proto3,oneof"`
+func (x *ReviewJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ChannelStreamRequest_UserMessage_NewUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
-
-func (*ChannelStreamRequest_UserMessage_EditUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
-
-func (*ChannelStreamRequest_UserMessage_DeleteUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
+// Deprecated: Use ReviewJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReviewJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{22}
+}
 
-type ChannelStreamRequest_UserMessage_NewUserMessage struct {
+// A code, which lets any user, who knows it, join the channel.
+type InviteCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-}
-
-func (x *ChannelStreamRequest_UserMessage_NewUserMessage) Reset() {
-	*x = ChannelStreamRequest_UserMessage_NewUserMessage{}
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ChannelId uint64 `protobuf:"fixed64,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// users get the role on joining with the code
+	Role         Role                 `protobuf:"varint,3,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	CustomRoleId int32                `protobuf:"varint,4,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
+	CreatedBy    string               `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// the code never expires if it is not set
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// the code can be used any number of times if it is zero
+	MaxUses uint32 `protobuf:"varint,8,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses    uint32 `protobuf:"varint,9,opt,name=uses,proto3" json:"uses,omitempty"`
+	// users, who have joined the channel with the code, in the order they
+	// have redeemed it
+	Redemptions []*InviteCode_Redemption `protobuf:"bytes,10,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+}
+
+func (x *InviteCode) Reset() {
+	*x = InviteCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[20]
+		mi := &file_accord_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamRequest_UserMessage_NewUserMessage) String() string {
+func (x *InviteCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamRequest_UserMessage_NewUserMessage) ProtoMessage() {}
+func (*InviteCode) ProtoMessage() {}
 
-func (x *ChannelStreamRequest_UserMessage_NewUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[20]
+func (x *InviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,98 +1511,112 @@ func (x *ChannelStreamRequest_UserMessage_NewUserMessage) ProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamRequest_UserMessage_NewUserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamRequest_UserMessage_NewUserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{9, 0, 0}
+// Deprecated: Use InviteCode.ProtoReflect.Descriptor instead.
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ChannelStreamRequest_UserMessage_NewUserMessage) GetContent() string {
+func (x *InviteCode) GetCode() string {
 	if x != nil {
-		return x.Content
+		return x.Code
 	}
 	return ""
 }
 
-type ChannelStreamRequest_UserMessage_EditUserMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *InviteCode) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
 
-	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Content   string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+func (x *InviteCode) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
 }
 
-func (x *ChannelStreamRequest_UserMessage_EditUserMessage) Reset() {
-	*x = ChannelStreamRequest_UserMessage_EditUserMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InviteCode) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
 	}
+	return 0
 }
 
-func (x *ChannelStreamRequest_UserMessage_EditUserMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InviteCode) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
 }
 
-func (*ChannelStreamRequest_UserMessage_EditUserMessage) ProtoMessage() {}
+func (x *InviteCode) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-func (x *ChannelStreamRequest_UserMessage_EditUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InviteCode) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ChannelStreamRequest_UserMessage_EditUserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamRequest_UserMessage_EditUserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{9, 0, 1}
+func (x *InviteCode) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
 }
 
-func (x *ChannelStreamRequest_UserMessage_EditUserMessage) GetMessageId() uint64 {
+func (x *InviteCode) GetUses() uint32 {
 	if x != nil {
-		return x.MessageId
+		return x.Uses
 	}
 	return 0
 }
 
-func (x *ChannelStreamRequest_UserMessage_EditUserMessage) GetContent() string {
+func (x *InviteCode) GetRedemptions() []*InviteCode_Redemption {
 	if x != nil {
-		return x.Content
+		return x.Redemptions
 	}
-	return ""
+	return nil
 }
 
-type ChannelStreamRequest_UserMessage_DeleteUserMessage struct {
+// Creates a new invite code of the channel with the role, or MEMBER if the
+// role is not set. Direct channels cannot have invite codes.
+type CreateInviteCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId    uint64               `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Role         Role                 `protobuf:"varint,2,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	CustomRoleId int32                `protobuf:"varint,3,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
+	ExpiresAt    *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxUses      uint32               `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
 }
 
-func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) Reset() {
-	*x = ChannelStreamRequest_UserMessage_DeleteUserMessage{}
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[22]
+		mi := &file_accord_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) String() string {
+func (x *CreateInviteCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamRequest_UserMessage_DeleteUserMessage) ProtoMessage() {}
+func (*CreateInviteCodeRequest) ProtoMessage() {}
 
-func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[22]
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,47 +1627,71 @@ func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamRequest_UserMessage_DeleteUserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamRequest_UserMessage_DeleteUserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{9, 0, 2}
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) GetMessageId() uint64 {
+func (x *CreateInviteCodeRequest) GetChannelId() uint64 {
 	if x != nil {
-		return x.MessageId
+		return x.ChannelId
 	}
 	return 0
 }
 
-type ChannelStreamResponse_UserMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	// Types that are assignable to UserMsg:
-	//	*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg
-	//	*ChannelStreamResponse_UserMessage_DeleteUserMsg
-	UserMsg isChannelStreamResponse_UserMessage_UserMsg `protobuf_oneof:"user_msg"`
+func (x *CreateInviteCodeRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
 }
 
-func (x *ChannelStreamResponse_UserMessage) Reset() {
-	*x = ChannelStreamResponse_UserMessage{}
+func (x *CreateInviteCodeRequest) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
+	}
+	return 0
+}
+
+func (x *CreateInviteCodeRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteCodeRequest) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type CreateInviteCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteCode *InviteCode `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+}
+
+func (x *CreateInviteCodeResponse) Reset() {
+	*x = CreateInviteCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[23]
+		mi := &file_accord_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamResponse_UserMessage) String() string {
+func (x *CreateInviteCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamResponse_UserMessage) ProtoMessage() {}
+func (*CreateInviteCodeResponse) ProtoMessage() {}
 
-func (x *ChannelStreamResponse_UserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[23]
+func (x *CreateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,83 +1702,140 @@ func (x *ChannelStreamResponse_UserMessage) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamResponse_UserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamResponse_UserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use CreateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ChannelStreamResponse_UserMessage) GetMessageId() uint64 {
+func (x *CreateInviteCodeResponse) GetInviteCode() *InviteCode {
 	if x != nil {
-		return x.MessageId
+		return x.InviteCode
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelStreamResponse_UserMessage) GetUserMsg() isChannelStreamResponse_UserMessage_UserMsg {
-	if m != nil {
-		return m.UserMsg
+// Requests all invite codes of the channel, including expired and used up ones.
+type GetInviteCodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *GetInviteCodesRequest) Reset() {
+	*x = GetInviteCodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ChannelStreamResponse_UserMessage) GetNewAndUpdateUserMsg() *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage {
-	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg); ok {
-		return x.NewAndUpdateUserMsg
+func (x *GetInviteCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteCodesRequest) ProtoMessage() {}
+
+func (x *GetInviteCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ChannelStreamResponse_UserMessage) GetDeleteUserMsg() *ChannelStreamResponse_UserMessage_DeleteUserMessage {
-	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_DeleteUserMsg); ok {
-		return x.DeleteUserMsg
+// Deprecated: Use GetInviteCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteCodesRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetInviteCodesRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-type isChannelStreamResponse_UserMessage_UserMsg interface {
-	isChannelStreamResponse_UserMessage_UserMsg()
+type GetInviteCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invite codes sorted by the time they were created
+	InviteCodes []*InviteCode `protobuf:"bytes,1,rep,name=invite_codes,json=inviteCodes,proto3" json:"invite_codes,omitempty"`
 }
 
-type ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg struct {
-	NewAndUpdateUserMsg *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage `protobuf:"bytes,2,opt,name=new_and_update_user_msg,json=newAndUpdateUserMsg,proto3,oneof"`
+func (x *GetInviteCodesResponse) Reset() {
+	*x = GetInviteCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type ChannelStreamResponse_UserMessage_DeleteUserMsg struct {
-	DeleteUserMsg *ChannelStreamResponse_UserMessage_DeleteUserMessage `protobuf:"bytes,3,opt,name=delete_user_msg,json=deleteUserMsg,proto3,oneof"`
+func (x *GetInviteCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg) isChannelStreamResponse_UserMessage_UserMsg() {
+func (*GetInviteCodesResponse) ProtoMessage() {}
+
+func (x *GetInviteCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ChannelStreamResponse_UserMessage_DeleteUserMsg) isChannelStreamResponse_UserMessage_UserMsg() {
+// Deprecated: Use GetInviteCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetInviteCodesResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{27}
 }
 
-type ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage struct {
+func (x *GetInviteCodesResponse) GetInviteCodes() []*InviteCode {
+	if x != nil {
+		return x.InviteCodes
+	}
+	return nil
+}
+
+type RevokeInviteCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) Reset() {
-	*x = ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage{}
+func (x *RevokeInviteCodeRequest) Reset() {
+	*x = RevokeInviteCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[24]
+		mi := &file_accord_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) String() string {
+func (x *RevokeInviteCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) ProtoMessage() {}
+func (*RevokeInviteCodeRequest) ProtoMessage() {}
 
-func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[24]
+func (x *RevokeInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,48 +1846,48 @@ func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) ProtoReflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{10, 0, 0}
+// Deprecated: Use RevokeInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *RevokeInviteCodeRequest) GetChannelId() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetContent() string {
+func (x *RevokeInviteCodeRequest) GetCode() string {
 	if x != nil {
-		return x.Content
+		return x.Code
 	}
 	return ""
 }
 
-type ChannelStreamResponse_UserMessage_DeleteUserMessage struct {
+type RevokeInviteCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) Reset() {
-	*x = ChannelStreamResponse_UserMessage_DeleteUserMessage{}
+func (x *RevokeInviteCodeResponse) Reset() {
+	*x = RevokeInviteCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_accord_proto_msgTypes[25]
+		mi := &file_accord_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) String() string {
+func (x *RevokeInviteCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelStreamResponse_UserMessage_DeleteUserMessage) ProtoMessage() {}
+func (*RevokeInviteCodeResponse) ProtoMessage() {}
 
-func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_accord_proto_msgTypes[25]
+func (x *RevokeInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,324 +1898,5218 @@ func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelStreamResponse_UserMessage_DeleteUserMessage.ProtoReflect.Descriptor instead.
-func (*ChannelStreamResponse_UserMessage_DeleteUserMessage) Descriptor() ([]byte, []int) {
-	return file_accord_proto_rawDescGZIP(), []int{10, 0, 1}
+// Deprecated: Use RevokeInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{29}
 }
 
-var File_accord_proto protoreflect.FileDescriptor
+// Makes the caller a member of the channel of the invite code.
+type RedeemInviteCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_accord_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x33, 0x0a, 0x12,
-	0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x52, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x61, 0x73, 0x1a, 0x63, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x68, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xb6, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a,
-	0x36, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x1a, 0xa5, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x1a, 0x59, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xf0, 0x03, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x63, 0x63,
-	0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x52, 0x0a, 0x08,
-	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67,
-	0x12, 0x4f, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x50, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x4d, 0x73,
-	0x67, 0x1a, 0x44, 0x0a, 0x18, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a,
-	0x10, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x58, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x1a, 0x38, 0x0a, 0x17, 0x50, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06,
-	0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0xad, 0x05, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
-	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x73,
-	0x67, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x73, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x73, 0x67,
-	0x1a, 0xe8, 0x03, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x5b, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x5e, 0x0a,
-	0x0d, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x0b, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x64, 0x0a,
-	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x4d, 0x73, 0x67, 0x1a, 0x2a, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a,
-	0x4a, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x32, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x42,
-	0x0a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x42, 0x05, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0xc6, 0x04, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x4d, 0x73, 0x67, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
-	0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x4d, 0x73, 0x67, 0x1a, 0x9e, 0x03, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x79, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e,
-	0x65, 0x77, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6e, 0x65, 0x77, 0x41, 0x6e, 0x64,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x65, 0x0a,
-	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x73, 0x67, 0x1a, 0x6d, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x41, 0x6e, 0x64, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x1a, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6d, 0x73, 0x67, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x89, 0x01, 0x0a, 0x0a,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x04, 0x12,
-	0x08, 0x0a, 0x04, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x4e,
-	0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x4c,
-	0x45, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x2a, 0x4f, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10,
-	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x50, 0x45,
-	0x52, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x32, 0x82, 0x03, 0x0a, 0x04, 0x43, 0x68, 0x61,
-	0x74, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0x19, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63, 0x63,
-	0x6f, 0x72, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x72, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x19, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63,
-	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x61, 0x63, 0x63,
-	0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
-	0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x06, 0x5a,
-	0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-var (
-	file_accord_proto_rawDescOnce sync.Once
-	file_accord_proto_rawDescData = file_accord_proto_rawDesc
-)
+func (x *RedeemInviteCodeRequest) Reset() {
+	*x = RedeemInviteCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_accord_proto_rawDescGZIP() []byte {
-	file_accord_proto_rawDescOnce.Do(func() {
-		file_accord_proto_rawDescData = protoimpl.X.CompressGZIP(file_accord_proto_rawDescData)
-	})
-	return file_accord_proto_rawDescData
+func (x *RedeemInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_accord_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_accord_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_accord_proto_goTypes = []interface{}{
-	(Permission)(0),                         // 0: accord.Permission
-	(Role)(0),                               // 1: accord.Role
-	(*AddChannelRequest)(nil),               // 2: accord.AddChannelRequest
-	(*AddChannelResponse)(nil),              // 3: accord.AddChannelResponse
-	(*RemoveChannelRequest)(nil),            // 4: accord.RemoveChannelRequest
-	(*RemoveChannelResponse)(nil),           // 5: accord.RemoveChannelResponse
-	(*GetChannelsRequest)(nil),              // 6: accord.GetChannelsRequest
-	(*GetChannelsResponse)(nil),             // 7: accord.GetChannelsResponse
-	(*GetChannelRequest)(nil),               // 8: accord.GetChannelRequest
-	(*GetChannelResponse)(nil),              // 9: accord.GetChannelResponse
-	(*ChannelConfigMessage)(nil),            // 10: accord.ChannelConfigMessage
-	(*ChannelStreamRequest)(nil),            // 11: accord.ChannelStreamRequest
-	(*ChannelStreamResponse)(nil),           // 12: accord.ChannelStreamResponse
-	(*GetChannelsResponse_ChannelMeta)(nil), // 13: accord.GetChannelsResponse.ChannelMeta
-	nil,                                     // 14: accord.GetChannelsResponse.ChannelMetasEntry
-	(*GetChannelResponse_User)(nil),         // 15: accord.GetChannelResponse.User
-	(*GetChannelResponse_ChannelInfo)(nil),  // 16: accord.GetChannelResponse.ChannelInfo
-	nil,                                     // 17: accord.GetChannelResponse.ChannelInfo.UsersEntry
-	(*ChannelConfigMessage_NameChannelConfigMessage)(nil),             // 18: accord.ChannelConfigMessage.NameChannelConfigMessage
-	(*ChannelConfigMessage_RoleChannelConfigMessage)(nil),             // 19: accord.ChannelConfigMessage.RoleChannelConfigMessage
-	(*ChannelConfigMessage_PinChannelConfigMessage)(nil),              // 20: accord.ChannelConfigMessage.PinChannelConfigMessage
-	(*ChannelStreamRequest_UserMessage)(nil),                          // 21: accord.ChannelStreamRequest.UserMessage
-	(*ChannelStreamRequest_UserMessage_NewUserMessage)(nil),           // 22: accord.ChannelStreamRequest.UserMessage.NewUserMessage
-	(*ChannelStreamRequest_UserMessage_EditUserMessage)(nil),          // 23: accord.ChannelStreamRequest.UserMessage.EditUserMessage
-	(*ChannelStreamRequest_UserMessage_DeleteUserMessage)(nil),        // 24: accord.ChannelStreamRequest.UserMessage.DeleteUserMessage
-	(*ChannelStreamResponse_UserMessage)(nil),                         // 25: accord.ChannelStreamResponse.UserMessage
-	(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage)(nil), // 26: accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage
-	(*ChannelStreamResponse_UserMessage_DeleteUserMessage)(nil),       // 27: accord.ChannelStreamResponse.UserMessage.DeleteUserMessage
-	(*timestamp.Timestamp)(nil),                                       // 28: google.protobuf.Timestamp
+func (*RedeemInviteCodeRequest) ProtoMessage() {}
+
+func (x *RedeemInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_accord_proto_depIdxs = []int32{
-	14, // 0: accord.GetChannelsResponse.channel_metas:type_name -> accord.GetChannelsResponse.ChannelMetasEntry
-	16, // 1: accord.GetChannelResponse.channel:type_name -> accord.GetChannelResponse.ChannelInfo
-	18, // 2: accord.ChannelConfigMessage.name_msg:type_name -> accord.ChannelConfigMessage.NameChannelConfigMessage
-	19, // 3: accord.ChannelConfigMessage.role_msg:type_name -> accord.ChannelConfigMessage.RoleChannelConfigMessage
-	20, // 4: accord.ChannelConfigMessage.pin_msg:type_name -> accord.ChannelConfigMessage.PinChannelConfigMessage
-	21, // 5: accord.ChannelStreamRequest.user_msg:type_name -> accord.ChannelStreamRequest.UserMessage
-	10, // 6: accord.ChannelStreamRequest.config_msg:type_name -> accord.ChannelConfigMessage
-	25, // 7: accord.ChannelStreamResponse.user_msg:type_name -> accord.ChannelStreamResponse.UserMessage
-	10, // 8: accord.ChannelStreamResponse.config_msg:type_name -> accord.ChannelConfigMessage
-	13, // 9: accord.GetChannelsResponse.ChannelMetasEntry.value:type_name -> accord.GetChannelsResponse.ChannelMeta
-	17, // 10: accord.GetChannelResponse.ChannelInfo.users:type_name -> accord.GetChannelResponse.ChannelInfo.UsersEntry
-	15, // 11: accord.GetChannelResponse.ChannelInfo.UsersEntry.value:type_name -> accord.GetChannelResponse.User
-	1,  // 12: accord.ChannelConfigMessage.RoleChannelConfigMessage.role:type_name -> accord.Role
-	22, // 13: accord.ChannelStreamRequest.UserMessage.new_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.NewUserMessage
-	23, // 14: accord.ChannelStreamRequest.UserMessage.edit_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.EditUserMessage
-	24, // 15: accord.ChannelStreamRequest.UserMessage.delete_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.DeleteUserMessage
-	26, // 16: accord.ChannelStreamResponse.UserMessage.new_and_update_user_msg:type_name -> accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage
-	27, // 17: accord.ChannelStreamResponse.UserMessage.delete_user_msg:type_name -> accord.ChannelStreamResponse.UserMessage.DeleteUserMessage
-	28, // 18: accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 19: accord.Chat.AddChannel:input_type -> accord.AddChannelRequest
-	4,  // 20: accord.Chat.RemoveChannel:input_type -> accord.RemoveChannelRequest
-	6,  // 21: accord.Chat.GetChannels:input_type -> accord.GetChannelsRequest
-	8,  // 22: accord.Chat.GetChannel:input_type -> accord.GetChannelRequest
-	11, // 23: accord.Chat.ChannelStream:input_type -> accord.ChannelStreamRequest
-	3,  // 24: accord.Chat.AddChannel:output_type -> accord.AddChannelResponse
-	5,  // 25: accord.Chat.RemoveChannel:output_type -> accord.RemoveChannelResponse
-	7,  // 26: accord.Chat.GetChannels:output_type -> accord.GetChannelsResponse
-	9,  // 27: accord.Chat.GetChannel:output_type -> accord.GetChannelResponse
-	12, // 28: accord.Chat.ChannelStream:output_type -> accord.ChannelStreamResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+
+// Deprecated: Use RedeemInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RedeemInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{30}
 }
 
-func init() { file_accord_proto_init() }
-func file_accord_proto_init() {
-	if File_accord_proto != nil {
-		return
+func (x *RedeemInviteCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_accord_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddChannelRequest); i {
+	return ""
+}
+
+type RedeemInviteCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *RedeemInviteCodeResponse) Reset() {
+	*x = RedeemInviteCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteCodeResponse) ProtoMessage() {}
+
+func (x *RedeemInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RedeemInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RedeemInviteCodeResponse) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+// Opens the direct channel of the caller and the users, which is created if
+// there is no such channel yet. Direct channels are private, and all of their
+// users are members, who cannot be changed.
+type OpenDirectChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users other than the caller, there has to be at least one of them
+	Usernames []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+}
+
+func (x *OpenDirectChannelRequest) Reset() {
+	*x = OpenDirectChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenDirectChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectChannelRequest) ProtoMessage() {}
+
+func (x *OpenDirectChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectChannelRequest.ProtoReflect.Descriptor instead.
+func (*OpenDirectChannelRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *OpenDirectChannelRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type OpenDirectChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *OpenDirectChannelResponse) Reset() {
+	*x = OpenDirectChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenDirectChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectChannelResponse) ProtoMessage() {}
+
+func (x *OpenDirectChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectChannelResponse.ProtoReflect.Descriptor instead.
+func (*OpenDirectChannelResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OpenDirectChannelResponse) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+// A single message from the channel's history.
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64               `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Content   string               `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Username of the author, set by the server.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id of the message, which this message replies to, or zero.
+	ReplyTo uint64 `protobuf:"fixed64,5,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	// Id of the first message of the thread, which this message is a reply
+	// in, or zero if it is not a reply.
+	ThreadRootId uint64 `protobuf:"fixed64,6,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+	// Number of replies (which are not deleted) and the time of the last
+	// reply in the thread started by this message.
+	ReplyCount  uint32               `protobuf:"varint,7,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	LastReplyAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_reply_at,json=lastReplyAt,proto3" json:"last_reply_at,omitempty"`
+	Reactions   []*Reaction          `protobuf:"bytes,9,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// Members other than the sender, who have read the message. It is only
+	// set if it has been requested.
+	SeenBy []string `protobuf:"bytes,10,rep,name=seen_by,json=seenBy,proto3" json:"seen_by,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Message) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Message) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Message) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Message) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Message) GetReplyTo() uint64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+func (x *Message) GetThreadRootId() uint64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *Message) GetReplyCount() uint32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Message) GetLastReplyAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastReplyAt
+	}
+	return nil
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetSeenBy() []string {
+	if x != nil {
+		return x.SeenBy
+	}
+	return nil
+}
+
+// Reactions to a message with a single emoji, in the order they were added.
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji     string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count     uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Usernames []string `protobuf:"bytes,3,rep,name=usernames,proto3" json:"usernames,omitempty"`
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+// Requests a page of the channel's history. Only messages with Ids
+// between after_id and before_id (both exclusive) are returned, where
+// zero means that there is no bound. If only after_id is set, the
+// oldest messages after it are returned. Otherwise, the newest messages
+// before before_id are returned.
+type GetMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	BeforeId  uint64 `protobuf:"fixed64,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	AfterId   uint64 `protobuf:"fixed64,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// maximal number of messages in the page, server's default is used if
+	// it is not positive.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// if set, seen_by of the messages is filled, only members of the channel
+	// can set it
+	IncludeSeenBy bool `protobuf:"varint,5,opt,name=include_seen_by,json=includeSeenBy,proto3" json:"include_seen_by,omitempty"`
+}
+
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesRequest) ProtoMessage() {}
+
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMessagesRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetBeforeId() uint64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetAfterId() uint64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetIncludeSeenBy() bool {
+	if x != nil {
+		return x.IncludeSeenBy
+	}
+	return false
+}
+
+type GetMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// messages sorted by their Ids in increasing order.
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// Requests a page of replies in the thread, which are paged the same way
+// as messages by GetMessagesRequest.
+type GetThreadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId    uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ThreadRootId uint64 `protobuf:"fixed64,2,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+	BeforeId     uint64 `protobuf:"fixed64,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	AfterId      uint64 `protobuf:"fixed64,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	PageSize     int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetThreadRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetThreadRootId() uint64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetBeforeId() uint64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetAfterId() uint64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetThreadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// root is the first message of the thread, which is not set if it has
+	// been deleted.
+	Root *Message `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// replies sorted by their Ids in increasing order.
+	Replies []*Message `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetThreadResponse) GetRoot() *Message {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetReplies() []*Message {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// Changes presence of the caller on all of the caller's connections. Only
+// ONLINE and IDLE can be set, since users are offline only while they are
+// not connected.
+type SetPresenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       PresenceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=accord.PresenceStatus" json:"status,omitempty"`
+	CustomStatus string         `protobuf:"bytes,2,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+}
+
+func (x *SetPresenceRequest) Reset() {
+	*x = SetPresenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPresenceRequest) ProtoMessage() {}
+
+func (x *SetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*SetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetPresenceRequest) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_OFFLINE
+}
+
+func (x *SetPresenceRequest) GetCustomStatus() string {
+	if x != nil {
+		return x.CustomStatus
+	}
+	return ""
+}
+
+type SetPresenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Presence *Presence `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
+}
+
+func (x *SetPresenceResponse) Reset() {
+	*x = SetPresenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPresenceResponse) ProtoMessage() {}
+
+func (x *SetPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPresenceResponse.ProtoReflect.Descriptor instead.
+func (*SetPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetPresenceResponse) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+// The user is identified by the access token, so no other information is
+// needed to start streaming server-wide events.
+type ServerStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerStreamRequest) Reset() {
+	*x = ServerStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamRequest) ProtoMessage() {}
+
+func (x *ServerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamRequest.ProtoReflect.Descriptor instead.
+func (*ServerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{42}
+}
+
+type ServerStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*ServerStreamResponse_ChannelAction_
+	//	*ServerStreamResponse_AnyOtherServerConfigChange_
+	Event isServerStreamResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *ServerStreamResponse) Reset() {
+	*x = ServerStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse) ProtoMessage() {}
+
+func (x *ServerStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *ServerStreamResponse) GetEvent() isServerStreamResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *ServerStreamResponse) GetChannelAction() *ServerStreamResponse_ChannelAction {
+	if x, ok := x.GetEvent().(*ServerStreamResponse_ChannelAction_); ok {
+		return x.ChannelAction
+	}
+	return nil
+}
+
+func (x *ServerStreamResponse) GetAnyOtherServerConfigChange() *ServerStreamResponse_AnyOtherServerConfigChange {
+	if x, ok := x.GetEvent().(*ServerStreamResponse_AnyOtherServerConfigChange_); ok {
+		return x.AnyOtherServerConfigChange
+	}
+	return nil
+}
+
+type isServerStreamResponse_Event interface {
+	isServerStreamResponse_Event()
+}
+
+type ServerStreamResponse_ChannelAction_ struct {
+	ChannelAction *ServerStreamResponse_ChannelAction `protobuf:"bytes,1,opt,name=channel_action,json=channelAction,proto3,oneof"`
+}
+
+type ServerStreamResponse_AnyOtherServerConfigChange_ struct {
+	AnyOtherServerConfigChange *ServerStreamResponse_AnyOtherServerConfigChange `protobuf:"bytes,2,opt,name=any_other_server_config_change,json=anyOtherServerConfigChange,proto3,oneof"`
+}
+
+func (*ServerStreamResponse_ChannelAction_) isServerStreamResponse_Event() {}
+
+func (*ServerStreamResponse_AnyOtherServerConfigChange_) isServerStreamResponse_Event() {}
+
+// Used in ChannelStreamRequest- and Response to initiate and broadcast
+// channel-related changes.
+type ChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*ChannelConfigMessage_NameMsg
+	//	*ChannelConfigMessage_RoleMsg
+	//	*ChannelConfigMessage_PinMsg
+	//	*ChannelConfigMessage_DefineRoleMsg
+	//	*ChannelConfigMessage_RemoveRoleMsg
+	//	*ChannelConfigMessage_KickMsg
+	//	*ChannelConfigMessage_BanMsg
+	//	*ChannelConfigMessage_UnbanMsg
+	//	*ChannelConfigMessage_InviteCodeRedeemedMsg
+	Msg isChannelConfigMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *ChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44}
+}
+
+func (m *ChannelConfigMessage) GetMsg() isChannelConfigMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetNameMsg() *ChannelConfigMessage_NameChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_NameMsg); ok {
+		return x.NameMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetRoleMsg() *ChannelConfigMessage_RoleChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_RoleMsg); ok {
+		return x.RoleMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetPinMsg() *ChannelConfigMessage_PinChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_PinMsg); ok {
+		return x.PinMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetDefineRoleMsg() *ChannelConfigMessage_DefineRoleChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_DefineRoleMsg); ok {
+		return x.DefineRoleMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetRemoveRoleMsg() *ChannelConfigMessage_RemoveRoleChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_RemoveRoleMsg); ok {
+		return x.RemoveRoleMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetKickMsg() *ChannelConfigMessage_KickChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_KickMsg); ok {
+		return x.KickMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetBanMsg() *ChannelConfigMessage_BanChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_BanMsg); ok {
+		return x.BanMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetUnbanMsg() *ChannelConfigMessage_UnbanChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_UnbanMsg); ok {
+		return x.UnbanMsg
+	}
+	return nil
+}
+
+func (x *ChannelConfigMessage) GetInviteCodeRedeemedMsg() *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelConfigMessage_InviteCodeRedeemedMsg); ok {
+		return x.InviteCodeRedeemedMsg
+	}
+	return nil
+}
+
+type isChannelConfigMessage_Msg interface {
+	isChannelConfigMessage_Msg()
+}
+
+type ChannelConfigMessage_NameMsg struct {
+	NameMsg *ChannelConfigMessage_NameChannelConfigMessage `protobuf:"bytes,1,opt,name=name_msg,json=nameMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_RoleMsg struct {
+	RoleMsg *ChannelConfigMessage_RoleChannelConfigMessage `protobuf:"bytes,2,opt,name=role_msg,json=roleMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_PinMsg struct {
+	PinMsg *ChannelConfigMessage_PinChannelConfigMessage `protobuf:"bytes,3,opt,name=pin_msg,json=pinMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_DefineRoleMsg struct {
+	DefineRoleMsg *ChannelConfigMessage_DefineRoleChannelConfigMessage `protobuf:"bytes,4,opt,name=define_role_msg,json=defineRoleMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_RemoveRoleMsg struct {
+	RemoveRoleMsg *ChannelConfigMessage_RemoveRoleChannelConfigMessage `protobuf:"bytes,5,opt,name=remove_role_msg,json=removeRoleMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_KickMsg struct {
+	KickMsg *ChannelConfigMessage_KickChannelConfigMessage `protobuf:"bytes,6,opt,name=kick_msg,json=kickMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_BanMsg struct {
+	BanMsg *ChannelConfigMessage_BanChannelConfigMessage `protobuf:"bytes,7,opt,name=ban_msg,json=banMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_UnbanMsg struct {
+	UnbanMsg *ChannelConfigMessage_UnbanChannelConfigMessage `protobuf:"bytes,8,opt,name=unban_msg,json=unbanMsg,proto3,oneof"`
+}
+
+type ChannelConfigMessage_InviteCodeRedeemedMsg struct {
+	InviteCodeRedeemedMsg *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage `protobuf:"bytes,9,opt,name=invite_code_redeemed_msg,json=inviteCodeRedeemedMsg,proto3,oneof"`
+}
+
+func (*ChannelConfigMessage_NameMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_RoleMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_PinMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_DefineRoleMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_RemoveRoleMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_KickMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_BanMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_UnbanMsg) isChannelConfigMessage_Msg() {}
+
+func (*ChannelConfigMessage_InviteCodeRedeemedMsg) isChannelConfigMessage_Msg() {}
+
+// Used in ChannelStreamRequest- and Response for short-lived events, which
+// are only delivered to other users streaming with the channel at the moment
+// and are never stored.
+type EphemeralMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the user who caused the event, set by the server
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Types that are assignable to Msg:
+	//	*EphemeralMessage_TypingMsg
+	//	*EphemeralMessage_PresenceMsg
+	Msg isEphemeralMessage_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *EphemeralMessage) Reset() {
+	*x = EphemeralMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemeralMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemeralMessage) ProtoMessage() {}
+
+func (x *EphemeralMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemeralMessage.ProtoReflect.Descriptor instead.
+func (*EphemeralMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EphemeralMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (m *EphemeralMessage) GetMsg() isEphemeralMessage_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *EphemeralMessage) GetTypingMsg() *EphemeralMessage_TypingEphemeralMessage {
+	if x, ok := x.GetMsg().(*EphemeralMessage_TypingMsg); ok {
+		return x.TypingMsg
+	}
+	return nil
+}
+
+func (x *EphemeralMessage) GetPresenceMsg() *EphemeralMessage_PresenceEphemeralMessage {
+	if x, ok := x.GetMsg().(*EphemeralMessage_PresenceMsg); ok {
+		return x.PresenceMsg
+	}
+	return nil
+}
+
+type isEphemeralMessage_Msg interface {
+	isEphemeralMessage_Msg()
+}
+
+type EphemeralMessage_TypingMsg struct {
+	TypingMsg *EphemeralMessage_TypingEphemeralMessage `protobuf:"bytes,2,opt,name=typing_msg,json=typingMsg,proto3,oneof"`
+}
+
+type EphemeralMessage_PresenceMsg struct {
+	PresenceMsg *EphemeralMessage_PresenceEphemeralMessage `protobuf:"bytes,3,opt,name=presence_msg,json=presenceMsg,proto3,oneof"`
+}
+
+func (*EphemeralMessage_TypingMsg) isEphemeralMessage_Msg() {}
+
+func (*EphemeralMessage_PresenceMsg) isEphemeralMessage_Msg() {}
+
+// Stream response for bidirectional streaming of user and  config
+// messages with a single channel.
+type ChannelStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Types that are assignable to Msg:
+	//	*ChannelStreamRequest_UserMsg
+	//	*ChannelStreamRequest_ConfigMsg
+	//	*ChannelStreamRequest_EphemeralMsg
+	//	*ChannelStreamRequest_SubscribeMsg
+	Msg isChannelStreamRequest_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *ChannelStreamRequest) Reset() {
+	*x = ChannelStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest) ProtoMessage() {}
+
+func (x *ChannelStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChannelStreamRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelStreamRequest) GetMsg() isChannelStreamRequest_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest) GetUserMsg() *ChannelStreamRequest_UserMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamRequest_UserMsg); ok {
+		return x.UserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest) GetConfigMsg() *ChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamRequest_ConfigMsg); ok {
+		return x.ConfigMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest) GetEphemeralMsg() *EphemeralMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamRequest_EphemeralMsg); ok {
+		return x.EphemeralMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest) GetSubscribeMsg() *ChannelStreamRequest_SubscribeMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamRequest_SubscribeMsg); ok {
+		return x.SubscribeMsg
+	}
+	return nil
+}
+
+type isChannelStreamRequest_Msg interface {
+	isChannelStreamRequest_Msg()
+}
+
+type ChannelStreamRequest_UserMsg struct {
+	UserMsg *ChannelStreamRequest_UserMessage `protobuf:"bytes,2,opt,name=user_msg,json=userMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_ConfigMsg struct {
+	ConfigMsg *ChannelConfigMessage `protobuf:"bytes,3,opt,name=config_msg,json=configMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_EphemeralMsg struct {
+	EphemeralMsg *EphemeralMessage `protobuf:"bytes,4,opt,name=ephemeral_msg,json=ephemeralMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_SubscribeMsg struct {
+	SubscribeMsg *ChannelStreamRequest_SubscribeMessage `protobuf:"bytes,5,opt,name=subscribe_msg,json=subscribeMsg,proto3,oneof"`
+}
+
+func (*ChannelStreamRequest_UserMsg) isChannelStreamRequest_Msg() {}
+
+func (*ChannelStreamRequest_ConfigMsg) isChannelStreamRequest_Msg() {}
+
+func (*ChannelStreamRequest_EphemeralMsg) isChannelStreamRequest_Msg() {}
+
+func (*ChannelStreamRequest_SubscribeMsg) isChannelStreamRequest_Msg() {}
+
+// Stream response for bidirectional streaming of user and  config
+// messages with a single channel.
+type ChannelStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*ChannelStreamResponse_UserMsg
+	//	*ChannelStreamResponse_ConfigMsg
+	//	*ChannelStreamResponse_EphemeralMsg
+	//	*ChannelStreamResponse_ChannelRemovedMsg
+	//	*ChannelStreamResponse_ErrorMsg
+	Msg isChannelStreamResponse_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *ChannelStreamResponse) Reset() {
+	*x = ChannelStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse) ProtoMessage() {}
+
+func (x *ChannelStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47}
+}
+
+func (m *ChannelStreamResponse) GetMsg() isChannelStreamResponse_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse) GetUserMsg() *ChannelStreamResponse_UserMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamResponse_UserMsg); ok {
+		return x.UserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse) GetConfigMsg() *ChannelConfigMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamResponse_ConfigMsg); ok {
+		return x.ConfigMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse) GetEphemeralMsg() *EphemeralMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamResponse_EphemeralMsg); ok {
+		return x.EphemeralMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse) GetChannelRemovedMsg() *ChannelStreamResponse_ChannelRemovedMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamResponse_ChannelRemovedMsg); ok {
+		return x.ChannelRemovedMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse) GetErrorMsg() *ChannelStreamResponse_ErrorMessage {
+	if x, ok := x.GetMsg().(*ChannelStreamResponse_ErrorMsg); ok {
+		return x.ErrorMsg
+	}
+	return nil
+}
+
+type isChannelStreamResponse_Msg interface {
+	isChannelStreamResponse_Msg()
+}
+
+type ChannelStreamResponse_UserMsg struct {
+	UserMsg *ChannelStreamResponse_UserMessage `protobuf:"bytes,1,opt,name=user_msg,json=userMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_ConfigMsg struct {
+	ConfigMsg *ChannelConfigMessage `protobuf:"bytes,2,opt,name=config_msg,json=configMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_EphemeralMsg struct {
+	EphemeralMsg *EphemeralMessage `protobuf:"bytes,3,opt,name=ephemeral_msg,json=ephemeralMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_ChannelRemovedMsg struct {
+	// The last response before the stream is closed by the server, since
+	// the channel has been removed.
+	ChannelRemovedMsg *ChannelStreamResponse_ChannelRemovedMessage `protobuf:"bytes,4,opt,name=channel_removed_msg,json=channelRemovedMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_ErrorMsg struct {
+	// Sent only to the stream, whose request has been rejected or has
+	// failed. The stream stays open.
+	ErrorMsg *ChannelStreamResponse_ErrorMessage `protobuf:"bytes,5,opt,name=error_msg,json=errorMsg,proto3,oneof"`
+}
+
+func (*ChannelStreamResponse_UserMsg) isChannelStreamResponse_Msg() {}
+
+func (*ChannelStreamResponse_ConfigMsg) isChannelStreamResponse_Msg() {}
+
+func (*ChannelStreamResponse_EphemeralMsg) isChannelStreamResponse_Msg() {}
+
+func (*ChannelStreamResponse_ChannelRemovedMsg) isChannelStreamResponse_Msg() {}
+
+func (*ChannelStreamResponse_ErrorMsg) isChannelStreamResponse_Msg() {}
+
+type GetChannelsResponse_ChannelMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic     bool   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	MembersCount int32  `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	// Id of the last message read by the caller, and the number of messages
+	// of others after it and of those, which mention the caller. The counts
+	// are zero if the caller is not a member.
+	LastReadId   uint64 `protobuf:"fixed64,5,opt,name=last_read_id,json=lastReadId,proto3" json:"last_read_id,omitempty"`
+	UnreadCount  int32  `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount int32  `protobuf:"varint,7,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	// direct channels are only listed for their members
+	IsDirect bool `protobuf:"varint,8,opt,name=is_direct,json=isDirect,proto3" json:"is_direct,omitempty"`
+}
+
+func (x *GetChannelsResponse_ChannelMeta) Reset() {
+	*x = GetChannelsResponse_ChannelMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelsResponse_ChannelMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelsResponse_ChannelMeta) ProtoMessage() {}
+
+func (x *GetChannelsResponse_ChannelMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelsResponse_ChannelMeta.ProtoReflect.Descriptor instead.
+func (*GetChannelsResponse_ChannelMeta) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetLastReadId() uint64 {
+	if x != nil {
+		return x.LastReadId
+	}
+	return 0
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetMentionCount() int32 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+func (x *GetChannelsResponse_ChannelMeta) GetIsDirect() bool {
+	if x != nil {
+		return x.IsDirect
+	}
+	return false
+}
+
+type GetChannelResponse_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role     int32     `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	Presence *Presence `protobuf:"bytes,3,opt,name=presence,proto3" json:"presence,omitempty"`
+}
+
+func (x *GetChannelResponse_User) Reset() {
+	*x = GetChannelResponse_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelResponse_User) ProtoMessage() {}
+
+func (x *GetChannelResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelResponse_User.ProtoReflect.Descriptor instead.
+func (*GetChannelResponse_User) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetChannelResponse_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetChannelResponse_User) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *GetChannelResponse_User) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type GetChannelResponse_ChannelInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId   uint64                              `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name        string                              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Users       map[string]*GetChannelResponse_User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PinnedMsgId uint64                              `protobuf:"varint,4,opt,name=pinned_msg_id,json=pinnedMsgId,proto3" json:"pinned_msg_id,omitempty"`
+	IsPublic    bool                                `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// both built-in and custom roles of the channel
+	Roles []*RoleDefinition `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	// active bans, only visible to users who can kick or ban
+	Bans     []*Ban `protobuf:"bytes,7,rep,name=bans,proto3" json:"bans,omitempty"`
+	IsDirect bool   `protobuf:"varint,8,opt,name=is_direct,json=isDirect,proto3" json:"is_direct,omitempty"`
+	// pending invites and requests to join, only visible to users who can
+	// assign roles
+	Invites      []*Invite      `protobuf:"bytes,9,rep,name=invites,proto3" json:"invites,omitempty"`
+	JoinRequests []*JoinRequest `protobuf:"bytes,10,rep,name=join_requests,json=joinRequests,proto3" json:"join_requests,omitempty"`
+}
+
+func (x *GetChannelResponse_ChannelInfo) Reset() {
+	*x = GetChannelResponse_ChannelInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelResponse_ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelResponse_ChannelInfo) ProtoMessage() {}
+
+func (x *GetChannelResponse_ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelResponse_ChannelInfo.ProtoReflect.Descriptor instead.
+func (*GetChannelResponse_ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetUsers() map[string]*GetChannelResponse_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetPinnedMsgId() uint64 {
+	if x != nil {
+		return x.PinnedMsgId
+	}
+	return 0
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetRoles() []*RoleDefinition {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetIsDirect() bool {
+	if x != nil {
+		return x.IsDirect
+	}
+	return false
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *GetChannelResponse_ChannelInfo) GetJoinRequests() []*JoinRequest {
+	if x != nil {
+		return x.JoinRequests
+	}
+	return nil
+}
+
+type InviteCode_Redemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string               `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RedeemedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+}
+
+func (x *InviteCode_Redemption) Reset() {
+	*x = InviteCode_Redemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteCode_Redemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCode_Redemption) ProtoMessage() {}
+
+func (x *InviteCode_Redemption) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCode_Redemption.ProtoReflect.Descriptor instead.
+func (*InviteCode_Redemption) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *InviteCode_Redemption) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteCode_Redemption) GetRedeemedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return nil
+}
+
+// After users call unary rpc to add/remove channel, it gets
+// broadcasted to all users (including the caller) through
+// this message.
+type ServerStreamResponse_ChannelAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId uint64 `protobuf:"fixed64,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Types that are assignable to Action:
+	//	*ServerStreamResponse_ChannelAction_AddChannel_
+	//	*ServerStreamResponse_ChannelAction_RemoveChannel_
+	//	*ServerStreamResponse_ChannelAction_RenameChannel_
+	Action isServerStreamResponse_ChannelAction_Action `protobuf_oneof:"action"`
+}
+
+func (x *ServerStreamResponse_ChannelAction) Reset() {
+	*x = ServerStreamResponse_ChannelAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse_ChannelAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse_ChannelAction) ProtoMessage() {}
+
+func (x *ServerStreamResponse_ChannelAction) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse_ChannelAction.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse_ChannelAction) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ServerStreamResponse_ChannelAction) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (m *ServerStreamResponse_ChannelAction) GetAction() isServerStreamResponse_ChannelAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *ServerStreamResponse_ChannelAction) GetAddChannel() *ServerStreamResponse_ChannelAction_AddChannel {
+	if x, ok := x.GetAction().(*ServerStreamResponse_ChannelAction_AddChannel_); ok {
+		return x.AddChannel
+	}
+	return nil
+}
+
+func (x *ServerStreamResponse_ChannelAction) GetRemoveChannel() *ServerStreamResponse_ChannelAction_RemoveChannel {
+	if x, ok := x.GetAction().(*ServerStreamResponse_ChannelAction_RemoveChannel_); ok {
+		return x.RemoveChannel
+	}
+	return nil
+}
+
+func (x *ServerStreamResponse_ChannelAction) GetRenameChannel() *ServerStreamResponse_ChannelAction_RenameChannel {
+	if x, ok := x.GetAction().(*ServerStreamResponse_ChannelAction_RenameChannel_); ok {
+		return x.RenameChannel
+	}
+	return nil
+}
+
+type isServerStreamResponse_ChannelAction_Action interface {
+	isServerStreamResponse_ChannelAction_Action()
+}
+
+type ServerStreamResponse_ChannelAction_AddChannel_ struct {
+	// AddChannel is for broadcasting to users that a new channel
+	// has been added.
+	AddChannel *ServerStreamResponse_ChannelAction_AddChannel `protobuf:"bytes,2,opt,name=add_channel,json=addChannel,proto3,oneof"`
+}
+
+type ServerStreamResponse_ChannelAction_RemoveChannel_ struct {
+	// Broadcasting to users that a channel has been removed.
+	RemoveChannel *ServerStreamResponse_ChannelAction_RemoveChannel `protobuf:"bytes,3,opt,name=remove_channel,json=removeChannel,proto3,oneof"`
+}
+
+type ServerStreamResponse_ChannelAction_RenameChannel_ struct {
+	// Broadcasting to users that a channel has been renamed.
+	RenameChannel *ServerStreamResponse_ChannelAction_RenameChannel `protobuf:"bytes,4,opt,name=rename_channel,json=renameChannel,proto3,oneof"`
+}
+
+func (*ServerStreamResponse_ChannelAction_AddChannel_) isServerStreamResponse_ChannelAction_Action() {
+}
+
+func (*ServerStreamResponse_ChannelAction_RemoveChannel_) isServerStreamResponse_ChannelAction_Action() {
+}
+
+func (*ServerStreamResponse_ChannelAction_RenameChannel_) isServerStreamResponse_ChannelAction_Action() {
+}
+
+// This is a placeholder to be renamed for emerging needs for
+// server configuration changes.
+type ServerStreamResponse_AnyOtherServerConfigChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerStreamResponse_AnyOtherServerConfigChange) Reset() {
+	*x = ServerStreamResponse_AnyOtherServerConfigChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse_AnyOtherServerConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse_AnyOtherServerConfigChange) ProtoMessage() {}
+
+func (x *ServerStreamResponse_AnyOtherServerConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse_AnyOtherServerConfigChange.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse_AnyOtherServerConfigChange) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43, 1}
+}
+
+// Additions of direct channels are only broadcasted to their members.
+type ServerStreamResponse_ChannelAction_AddChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic bool   `protobuf:"varint,2,opt,name=isPublic,proto3" json:"isPublic,omitempty"`
+	IsDirect bool   `protobuf:"varint,3,opt,name=is_direct,json=isDirect,proto3" json:"is_direct,omitempty"`
+}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) Reset() {
+	*x = ServerStreamResponse_ChannelAction_AddChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse_ChannelAction_AddChannel) ProtoMessage() {}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse_ChannelAction_AddChannel.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse_ChannelAction_AddChannel) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43, 0, 0}
+}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *ServerStreamResponse_ChannelAction_AddChannel) GetIsDirect() bool {
+	if x != nil {
+		return x.IsDirect
+	}
+	return false
+}
+
+type ServerStreamResponse_ChannelAction_RemoveChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerStreamResponse_ChannelAction_RemoveChannel) Reset() {
+	*x = ServerStreamResponse_ChannelAction_RemoveChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse_ChannelAction_RemoveChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse_ChannelAction_RemoveChannel) ProtoMessage() {}
+
+func (x *ServerStreamResponse_ChannelAction_RemoveChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse_ChannelAction_RemoveChannel.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse_ChannelAction_RemoveChannel) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43, 0, 1}
+}
+
+type ServerStreamResponse_ChannelAction_RenameChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewName string `protobuf:"bytes,1,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+}
+
+func (x *ServerStreamResponse_ChannelAction_RenameChannel) Reset() {
+	*x = ServerStreamResponse_ChannelAction_RenameChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStreamResponse_ChannelAction_RenameChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamResponse_ChannelAction_RenameChannel) ProtoMessage() {}
+
+func (x *ServerStreamResponse_ChannelAction_RenameChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamResponse_ChannelAction_RenameChannel.ProtoReflect.Descriptor instead.
+func (*ServerStreamResponse_ChannelAction_RenameChannel) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{43, 0, 2}
+}
+
+func (x *ServerStreamResponse_ChannelAction_RenameChannel) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type ChannelConfigMessage_NameChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewChannelName string `protobuf:"bytes,1,opt,name=new_channel_name,json=newChannelName,proto3" json:"new_channel_name,omitempty"`
+}
+
+func (x *ChannelConfigMessage_NameChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_NameChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_NameChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_NameChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_NameChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_NameChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_NameChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *ChannelConfigMessage_NameChannelConfigMessage) GetNewChannelName() string {
+	if x != nil {
+		return x.NewChannelName
+	}
+	return ""
+}
+
+type ChannelConfigMessage_RoleChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role     Role   `protobuf:"varint,2,opt,name=role,proto3,enum=accord.Role" json:"role,omitempty"`
+	// if set, the custom role with this Id is assigned instead of role.
+	CustomRoleId int32 `protobuf:"varint,3,opt,name=custom_role_id,json=customRoleId,proto3" json:"custom_role_id,omitempty"`
+}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_RoleChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_RoleChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_RoleChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_RoleChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 1}
+}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+func (x *ChannelConfigMessage_RoleChannelConfigMessage) GetCustomRoleId() int32 {
+	if x != nil {
+		return x.CustomRoleId
+	}
+	return 0
+}
+
+// Creates a new custom role if role_id of the definition is zero, or
+// redefines the existing custom role otherwise. It is broadcasted with
+// the Id assigned to the role. Only superadmins can define roles.
+type ChannelConfigMessage_DefineRoleChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role *RoleDefinition `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *ChannelConfigMessage_DefineRoleChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_DefineRoleChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_DefineRoleChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_DefineRoleChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_DefineRoleChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_DefineRoleChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_DefineRoleChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 2}
+}
+
+func (x *ChannelConfigMessage_DefineRoleChannelConfigMessage) GetRole() *RoleDefinition {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// Removes the custom role, users who had it become members.
+type ChannelConfigMessage_RemoveRoleChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleId int32 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+}
+
+func (x *ChannelConfigMessage_RemoveRoleChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_RemoveRoleChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_RemoveRoleChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_RemoveRoleChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_RemoveRoleChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_RemoveRoleChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_RemoveRoleChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 3}
+}
+
+func (x *ChannelConfigMessage_RemoveRoleChannelConfigMessage) GetRoleId() int32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+// Removes the user from the channel and closes the user's stream. The
+// user can join the channel again.
+type ChannelConfigMessage_KickChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ChannelConfigMessage_KickChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_KickChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_KickChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_KickChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_KickChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_KickChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_KickChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 4}
+}
+
+func (x *ChannelConfigMessage_KickChannelConfigMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Removes the user from the channel and doesn't let the user join it
+// again until the ban expires or is lifted. It is broadcasted with
+// all the fields of the ban filled by the server.
+type ChannelConfigMessage_BanChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ban *Ban `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *ChannelConfigMessage_BanChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_BanChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_BanChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_BanChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_BanChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_BanChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_BanChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 5}
+}
+
+func (x *ChannelConfigMessage_BanChannelConfigMessage) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type ChannelConfigMessage_UnbanChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ChannelConfigMessage_UnbanChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_UnbanChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_UnbanChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_UnbanChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_UnbanChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_UnbanChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_UnbanChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 6}
+}
+
+func (x *ChannelConfigMessage_UnbanChannelConfigMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ChannelConfigMessage_PinChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *ChannelConfigMessage_PinChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_PinChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_PinChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_PinChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_PinChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_PinChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_PinChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 7}
+}
+
+func (x *ChannelConfigMessage_PinChannelConfigMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// Sent by the server, when the user joins the channel with the invite
+// code, only to users, who can see invite codes of the channel. Clients
+// cannot send it.
+type ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       string               `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Username   string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RedeemedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) Reset() {
+	*x = ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) ProtoMessage() {}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage.ProtoReflect.Descriptor instead.
+func (*ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{44, 8}
+}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) GetRedeemedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return nil
+}
+
+// Sent repeatedly while the user is typing. The server throttles the
+// signals of each user, so clients should consider that the user stopped
+// typing at expires_at unless the signal is received again.
+type EphemeralMessage_TypingEphemeralMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// set if the user has stopped typing before the signal expired
+	Stopped bool `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	// set by the server
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *EphemeralMessage_TypingEphemeralMessage) Reset() {
+	*x = EphemeralMessage_TypingEphemeralMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemeralMessage_TypingEphemeralMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemeralMessage_TypingEphemeralMessage) ProtoMessage() {}
+
+func (x *EphemeralMessage_TypingEphemeralMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemeralMessage_TypingEphemeralMessage.ProtoReflect.Descriptor instead.
+func (*EphemeralMessage_TypingEphemeralMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *EphemeralMessage_TypingEphemeralMessage) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+func (x *EphemeralMessage_TypingEphemeralMessage) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Sent by the server to members of channels, which the user is in, when
+// the user's presence changes. It cannot be sent by clients.
+type EphemeralMessage_PresenceEphemeralMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Presence *Presence `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
+}
+
+func (x *EphemeralMessage_PresenceEphemeralMessage) Reset() {
+	*x = EphemeralMessage_PresenceEphemeralMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemeralMessage_PresenceEphemeralMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemeralMessage_PresenceEphemeralMessage) ProtoMessage() {}
+
+func (x *EphemeralMessage_PresenceEphemeralMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemeralMessage_PresenceEphemeralMessage.ProtoReflect.Descriptor instead.
+func (*EphemeralMessage_PresenceEphemeralMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *EphemeralMessage_PresenceEphemeralMessage) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+// Registers the stream with the channel without any changes to it, so that
+// users, who can only read the channel, can stream with it. It is sent by
+// clients as the first request, and the server sends the header of the
+// stream once the stream is registered.
+type ChannelStreamRequest_SubscribeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelStreamRequest_SubscribeMessage) Reset() {
+	*x = ChannelStreamRequest_SubscribeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_SubscribeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_SubscribeMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_SubscribeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_SubscribeMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_SubscribeMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type ChannelStreamRequest_UserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to UserMsg:
+	//	*ChannelStreamRequest_UserMessage_NewUserMsg
+	//	*ChannelStreamRequest_UserMessage_EditUserMsg
+	//	*ChannelStreamRequest_UserMessage_DeleteUserMsg
+	//	*ChannelStreamRequest_UserMessage_AddReactionMsg
+	//	*ChannelStreamRequest_UserMessage_RemoveReactionMsg
+	//	*ChannelStreamRequest_UserMessage_MarkReadMsg
+	UserMsg isChannelStreamRequest_UserMessage_UserMsg `protobuf_oneof:"user_msg"`
+}
+
+func (x *ChannelStreamRequest_UserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (m *ChannelStreamRequest_UserMessage) GetUserMsg() isChannelStreamRequest_UserMessage_UserMsg {
+	if m != nil {
+		return m.UserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetNewUserMsg() *ChannelStreamRequest_UserMessage_NewUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_NewUserMsg); ok {
+		return x.NewUserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetEditUserMsg() *ChannelStreamRequest_UserMessage_EditUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_EditUserMsg); ok {
+		return x.EditUserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetDeleteUserMsg() *ChannelStreamRequest_UserMessage_DeleteUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_DeleteUserMsg); ok {
+		return x.DeleteUserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetAddReactionMsg() *ChannelStreamRequest_UserMessage_AddReactionUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_AddReactionMsg); ok {
+		return x.AddReactionMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetRemoveReactionMsg() *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_RemoveReactionMsg); ok {
+		return x.RemoveReactionMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamRequest_UserMessage) GetMarkReadMsg() *ChannelStreamRequest_UserMessage_MarkReadUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamRequest_UserMessage_MarkReadMsg); ok {
+		return x.MarkReadMsg
+	}
+	return nil
+}
+
+type isChannelStreamRequest_UserMessage_UserMsg interface {
+	isChannelStreamRequest_UserMessage_UserMsg()
+}
+
+type ChannelStreamRequest_UserMessage_NewUserMsg struct {
+	NewUserMsg *ChannelStreamRequest_UserMessage_NewUserMessage `protobuf:"bytes,1,opt,name=new_user_msg,json=newUserMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_UserMessage_EditUserMsg struct {
+	EditUserMsg *ChannelStreamRequest_UserMessage_EditUserMessage `protobuf:"bytes,2,opt,name=edit_user_msg,json=editUserMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_UserMessage_DeleteUserMsg struct {
+	DeleteUserMsg *ChannelStreamRequest_UserMessage_DeleteUserMessage `protobuf:"bytes,3,opt,name=delete_user_msg,json=deleteUserMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_UserMessage_AddReactionMsg struct {
+	AddReactionMsg *ChannelStreamRequest_UserMessage_AddReactionUserMessage `protobuf:"bytes,4,opt,name=add_reaction_msg,json=addReactionMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_UserMessage_RemoveReactionMsg struct {
+	RemoveReactionMsg *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage `protobuf:"bytes,5,opt,name=remove_reaction_msg,json=removeReactionMsg,proto3,oneof"`
+}
+
+type ChannelStreamRequest_UserMessage_MarkReadMsg struct {
+	MarkReadMsg *ChannelStreamRequest_UserMessage_MarkReadUserMessage `protobuf:"bytes,6,opt,name=mark_read_msg,json=markReadMsg,proto3,oneof"`
+}
+
+func (*ChannelStreamRequest_UserMessage_NewUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
+
+func (*ChannelStreamRequest_UserMessage_EditUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
+
+func (*ChannelStreamRequest_UserMessage_DeleteUserMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
+
+func (*ChannelStreamRequest_UserMessage_AddReactionMsg) isChannelStreamRequest_UserMessage_UserMsg() {
+}
+
+func (*ChannelStreamRequest_UserMessage_RemoveReactionMsg) isChannelStreamRequest_UserMessage_UserMsg() {
+}
+
+func (*ChannelStreamRequest_UserMessage_MarkReadMsg) isChannelStreamRequest_UserMessage_UserMsg() {}
+
+type ChannelStreamRequest_UserMessage_NewUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Id of the message to reply to, the reply is added to its thread.
+	ReplyTo uint64 `protobuf:"fixed64,2,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_NewUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_NewUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_NewUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_NewUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_NewUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_NewUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_NewUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 0}
+}
+
+func (x *ChannelStreamRequest_UserMessage_NewUserMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChannelStreamRequest_UserMessage_NewUserMessage) GetReplyTo() uint64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+type ChannelStreamRequest_UserMessage_EditUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content   string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_EditUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_EditUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_EditUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_EditUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_EditUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_EditUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_EditUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 1}
+}
+
+func (x *ChannelStreamRequest_UserMessage_EditUserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ChannelStreamRequest_UserMessage_EditUserMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ChannelStreamRequest_UserMessage_DeleteUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_DeleteUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_DeleteUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_DeleteUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_DeleteUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 2}
+}
+
+func (x *ChannelStreamRequest_UserMessage_DeleteUserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// Adding the same reaction twice or removing a reaction, which hasn't
+// been added, has no effect.
+type ChannelStreamRequest_UserMessage_AddReactionUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji     string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_AddReactionUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_AddReactionUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_AddReactionUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_AddReactionUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_AddReactionUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_AddReactionUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_AddReactionUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 3}
+}
+
+func (x *ChannelStreamRequest_UserMessage_AddReactionUserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ChannelStreamRequest_UserMessage_AddReactionUserMessage) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type ChannelStreamRequest_UserMessage_RemoveReactionUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji     string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_RemoveReactionUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_RemoveReactionUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 4}
+}
+
+func (x *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ChannelStreamRequest_UserMessage_RemoveReactionUserMessage) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// Marks all the messages up to the message as read by the user. The
+// last read message never moves back.
+type ChannelStreamRequest_UserMessage_MarkReadUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *ChannelStreamRequest_UserMessage_MarkReadUserMessage) Reset() {
+	*x = ChannelStreamRequest_UserMessage_MarkReadUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamRequest_UserMessage_MarkReadUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamRequest_UserMessage_MarkReadUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamRequest_UserMessage_MarkReadUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamRequest_UserMessage_MarkReadUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamRequest_UserMessage_MarkReadUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{46, 1, 5}
+}
+
+func (x *ChannelStreamRequest_UserMessage_MarkReadUserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type ChannelStreamResponse_ChannelRemovedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the user who removed the channel
+	RemovedBy string `protobuf:"bytes,1,opt,name=removed_by,json=removedBy,proto3" json:"removed_by,omitempty"`
+}
+
+func (x *ChannelStreamResponse_ChannelRemovedMessage) Reset() {
+	*x = ChannelStreamResponse_ChannelRemovedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_ChannelRemovedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_ChannelRemovedMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_ChannelRemovedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_ChannelRemovedMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_ChannelRemovedMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ChannelStreamResponse_ChannelRemovedMessage) GetRemovedBy() string {
+	if x != nil {
+		return x.RemovedBy
+	}
+	return ""
+}
+
+type ChannelStreamResponse_ErrorMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gRPC status code of the error
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ChannelStreamResponse_ErrorMessage) Reset() {
+	*x = ChannelStreamResponse_ErrorMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_ErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_ErrorMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_ErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_ErrorMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 1}
+}
+
+func (x *ChannelStreamResponse_ErrorMessage) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ChannelStreamResponse_ErrorMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChannelStreamResponse_UserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"fixed64,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Types that are assignable to UserMsg:
+	//	*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg
+	//	*ChannelStreamResponse_UserMessage_DeleteUserMsg
+	//	*ChannelStreamResponse_UserMessage_ReactionsMsg
+	//	*ChannelStreamResponse_UserMessage_ReadMsg
+	UserMsg isChannelStreamResponse_UserMessage_UserMsg `protobuf_oneof:"user_msg"`
+	// Username of the author of the message.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Set for replies, so that subscribers can count replies in threads
+	// when new replies are added and deleted.
+	ReplyTo      uint64 `protobuf:"fixed64,5,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ThreadRootId uint64 `protobuf:"fixed64,6,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+}
+
+func (x *ChannelStreamResponse_UserMessage) Reset() {
+	*x = ChannelStreamResponse_UserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_UserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_UserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_UserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_UserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_UserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 2}
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (m *ChannelStreamResponse_UserMessage) GetUserMsg() isChannelStreamResponse_UserMessage_UserMsg {
+	if m != nil {
+		return m.UserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetNewAndUpdateUserMsg() *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg); ok {
+		return x.NewAndUpdateUserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetDeleteUserMsg() *ChannelStreamResponse_UserMessage_DeleteUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_DeleteUserMsg); ok {
+		return x.DeleteUserMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetReactionsMsg() *ChannelStreamResponse_UserMessage_ReactionsUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_ReactionsMsg); ok {
+		return x.ReactionsMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetReadMsg() *ChannelStreamResponse_UserMessage_ReadUserMessage {
+	if x, ok := x.GetUserMsg().(*ChannelStreamResponse_UserMessage_ReadMsg); ok {
+		return x.ReadMsg
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetReplyTo() uint64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+func (x *ChannelStreamResponse_UserMessage) GetThreadRootId() uint64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+type isChannelStreamResponse_UserMessage_UserMsg interface {
+	isChannelStreamResponse_UserMessage_UserMsg()
+}
+
+type ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg struct {
+	NewAndUpdateUserMsg *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage `protobuf:"bytes,2,opt,name=new_and_update_user_msg,json=newAndUpdateUserMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_UserMessage_DeleteUserMsg struct {
+	DeleteUserMsg *ChannelStreamResponse_UserMessage_DeleteUserMessage `protobuf:"bytes,3,opt,name=delete_user_msg,json=deleteUserMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_UserMessage_ReactionsMsg struct {
+	ReactionsMsg *ChannelStreamResponse_UserMessage_ReactionsUserMessage `protobuf:"bytes,7,opt,name=reactions_msg,json=reactionsMsg,proto3,oneof"`
+}
+
+type ChannelStreamResponse_UserMessage_ReadMsg struct {
+	ReadMsg *ChannelStreamResponse_UserMessage_ReadUserMessage `protobuf:"bytes,8,opt,name=read_msg,json=readMsg,proto3,oneof"`
+}
+
+func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg) isChannelStreamResponse_UserMessage_UserMsg() {
+}
+
+func (*ChannelStreamResponse_UserMessage_DeleteUserMsg) isChannelStreamResponse_UserMessage_UserMsg() {
+}
+
+func (*ChannelStreamResponse_UserMessage_ReactionsMsg) isChannelStreamResponse_UserMessage_UserMsg() {
+}
+
+func (*ChannelStreamResponse_UserMessage_ReadMsg) isChannelStreamResponse_UserMessage_UserMsg() {}
+
+type ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Content   string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Statistics of the thread started by the message.
+	ReplyCount  uint32               `protobuf:"varint,3,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	LastReplyAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_reply_at,json=lastReplyAt,proto3" json:"last_reply_at,omitempty"`
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) Reset() {
+	*x = ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 2, 0}
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetReplyCount() uint32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) GetLastReplyAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastReplyAt
+	}
+	return nil
+}
+
+type ChannelStreamResponse_UserMessage_DeleteUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) Reset() {
+	*x = ChannelStreamResponse_UserMessage_DeleteUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_UserMessage_DeleteUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_UserMessage_DeleteUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_UserMessage_DeleteUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_UserMessage_DeleteUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 2, 1}
+}
+
+// Sent when reactions to the message change, contains all of them.
+type ChannelStreamResponse_UserMessage_ReactionsUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReactionsUserMessage) Reset() {
+	*x = ChannelStreamResponse_UserMessage_ReactionsUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReactionsUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_UserMessage_ReactionsUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_UserMessage_ReactionsUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_UserMessage_ReactionsUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_UserMessage_ReactionsUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 2, 2}
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReactionsUserMessage) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// Sent when the user marks messages as read, message_id is the last
+// message read by the user and sender is not set.
+type ChannelStreamResponse_UserMessage_ReadUserMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReadUserMessage) Reset() {
+	*x = ChannelStreamResponse_UserMessage_ReadUserMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accord_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReadUserMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStreamResponse_UserMessage_ReadUserMessage) ProtoMessage() {}
+
+func (x *ChannelStreamResponse_UserMessage_ReadUserMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_accord_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStreamResponse_UserMessage_ReadUserMessage.ProtoReflect.Descriptor instead.
+func (*ChannelStreamResponse_UserMessage_ReadUserMessage) Descriptor() ([]byte, []int) {
+	return file_accord_proto_rawDescGZIP(), []int{47, 2, 3}
+}
+
+func (x *ChannelStreamResponse_UserMessage_ReadUserMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+var File_accord_proto protoreflect.FileDescriptor
+
+var file_accord_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xca, 0x01,
+	0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22,
+	0x33, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x03, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x1a, 0xea, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x1a, 0x68, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x22, 0xb4, 0x05, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x64, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x1a, 0xf5, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x2c, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x62,
+	0x61, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x59, 0x0a,
+	0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x16, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
+	0x0a, 0x18, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22,
+	0x1b, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3, 0x03, 0x0a,
+	0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65,
+	0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x65, 0x0a, 0x0a, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2d, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x39,
+	0x0a, 0x18, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x18, 0x4f, 0x70, 0x65,
+	0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22,
+	0xff, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x65, 0x6e,
+	0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x65, 0x6e, 0x42,
+	0x79, 0x22, 0x54, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x08, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x42, 0x79, 0x22, 0x42, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xac,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x08, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x22, 0x69, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x43, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x06, 0x0a, 0x14, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7d, 0x0a, 0x1e, 0x61, 0x6e, 0x79, 0x5f, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e,
+	0x79, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x61, 0x6e, 0x79, 0x4f,
+	0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0xf0, 0x03, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x61, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x61, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x59, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x1a, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x1a, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1c, 0x0a, 0x1a, 0x41, 0x6e, 0x79,
+	0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xac, 0x0c, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x52, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x4f, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x50, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x4d,
+	0x73, 0x67, 0x12, 0x65, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x65, 0x0a, 0x0f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67,
+	0x12, 0x52, 0x0a, 0x08, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6b, 0x69, 0x63,
+	0x6b, 0x4d, 0x73, 0x67, 0x12, 0x4f, 0x0a, 0x07, 0x62, 0x61, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x42, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x62,
+	0x61, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x7e, 0x0a, 0x18,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x15, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x44, 0x0a, 0x18,
+	0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x1a, 0x7e, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x1a, 0x4c, 0x0a, 0x1e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x1a, 0x39, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x1a, 0x36, 0x0a, 0x18, 0x4b,
+	0x69, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x1a, 0x38, 0x0a, 0x17, 0x42, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x1a, 0x37, 0x0a,
+	0x19, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x38, 0x0a, 0x17, 0x50, 0x69, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x1a, 0x95, 0x01, 0x0a, 0x26, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22,
+	0x98, 0x03, 0x0a, 0x10, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x50, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x45, 0x70,
+	0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54,
+	0x79, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x4d,
+	0x73, 0x67, 0x12, 0x56, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x6d, 0x0a, 0x16, 0x54, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x48, 0x0a, 0x18, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x91, 0x0b, 0x0a, 0x14, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65,
+	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x54, 0x0a, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x73, 0x67, 0x1a,
+	0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0xa1, 0x08, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x5e, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x64, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x6b, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x5f, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x67, 0x12, 0x74, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x62, 0x0a, 0x0d, 0x6d, 0x61, 0x72,
+	0x6b, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x45, 0x0a,
+	0x0e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x6f, 0x1a, 0x4a, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x1a, 0x32, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x1a, 0x4d, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x1a, 0x50, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x1a, 0x34, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xa0,
+	0x0b, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x73, 0x67, 0x12,
+	0x3f, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0c, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x73, 0x67,
+	0x12, 0x65, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x73, 0x67, 0x1a, 0x36, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x1a, 0x3c, 0x0a, 0x0c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x8f, 0x07, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x79, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6e,
+	0x65, 0x77, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x65, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x65, 0x0a, 0x0d, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67,
+	0x12, 0x56, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x49,
+	0x64, 0x1a, 0xce, 0x01, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x41, 0x74, 0x1a, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x46, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x2d, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x59, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x05, 0x12,
+	0x07, 0x0a, 0x03, 0x42, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x2a, 0x4f, 0x0a,
+	0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x42, 0x53, 0x43,
+	0x52, 0x49, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x0e,
+	0x0a, 0x0a, 0x53, 0x55, 0x50, 0x45, 0x52, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x2a, 0x33,
+	0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c,
+	0x45, 0x10, 0x02, 0x32, 0xf3, 0x0b, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x45, 0x0a, 0x0a,
+	0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x41,
+	0x64, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x19, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x61,
+	0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x10, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_accord_proto_rawDescOnce sync.Once
+	file_accord_proto_rawDescData = file_accord_proto_rawDesc
+)
+
+func file_accord_proto_rawDescGZIP() []byte {
+	file_accord_proto_rawDescOnce.Do(func() {
+		file_accord_proto_rawDescData = protoimpl.X.CompressGZIP(file_accord_proto_rawDescData)
+	})
+	return file_accord_proto_rawDescData
+}
+
+var file_accord_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_accord_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_accord_proto_goTypes = []interface{}{
+	(Permission)(0),                                                     // 0: accord.Permission
+	(Role)(0),                                                           // 1: accord.Role
+	(PresenceStatus)(0),                                                 // 2: accord.PresenceStatus
+	(*Presence)(nil),                                                    // 3: accord.Presence
+	(*RoleDefinition)(nil),                                              // 4: accord.RoleDefinition
+	(*Ban)(nil),                                                         // 5: accord.Ban
+	(*AddChannelRequest)(nil),                                           // 6: accord.AddChannelRequest
+	(*AddChannelResponse)(nil),                                          // 7: accord.AddChannelResponse
+	(*RemoveChannelRequest)(nil),                                        // 8: accord.RemoveChannelRequest
+	(*RemoveChannelResponse)(nil),                                       // 9: accord.RemoveChannelResponse
+	(*GetChannelsRequest)(nil),                                          // 10: accord.GetChannelsRequest
+	(*GetChannelsResponse)(nil),                                         // 11: accord.GetChannelsResponse
+	(*GetChannelRequest)(nil),                                           // 12: accord.GetChannelRequest
+	(*GetChannelResponse)(nil),                                          // 13: accord.GetChannelResponse
+	(*Invite)(nil),                                                      // 14: accord.Invite
+	(*JoinRequest)(nil),                                                 // 15: accord.JoinRequest
+	(*InviteUserRequest)(nil),                                           // 16: accord.InviteUserRequest
+	(*InviteUserResponse)(nil),                                          // 17: accord.InviteUserResponse
+	(*GetInvitesRequest)(nil),                                           // 18: accord.GetInvitesRequest
+	(*GetInvitesResponse)(nil),                                          // 19: accord.GetInvitesResponse
+	(*RespondToInviteRequest)(nil),                                      // 20: accord.RespondToInviteRequest
+	(*RespondToInviteResponse)(nil),                                     // 21: accord.RespondToInviteResponse
+	(*RequestToJoinRequest)(nil),                                        // 22: accord.RequestToJoinRequest
+	(*RequestToJoinResponse)(nil),                                       // 23: accord.RequestToJoinResponse
+	(*ReviewJoinRequestRequest)(nil),                                    // 24: accord.ReviewJoinRequestRequest
+	(*ReviewJoinRequestResponse)(nil),                                   // 25: accord.ReviewJoinRequestResponse
+	(*InviteCode)(nil),                                                  // 26: accord.InviteCode
+	(*CreateInviteCodeRequest)(nil),                                     // 27: accord.CreateInviteCodeRequest
+	(*CreateInviteCodeResponse)(nil),                                    // 28: accord.CreateInviteCodeResponse
+	(*GetInviteCodesRequest)(nil),                                       // 29: accord.GetInviteCodesRequest
+	(*GetInviteCodesResponse)(nil),                                      // 30: accord.GetInviteCodesResponse
+	(*RevokeInviteCodeRequest)(nil),                                     // 31: accord.RevokeInviteCodeRequest
+	(*RevokeInviteCodeResponse)(nil),                                    // 32: accord.RevokeInviteCodeResponse
+	(*RedeemInviteCodeRequest)(nil),                                     // 33: accord.RedeemInviteCodeRequest
+	(*RedeemInviteCodeResponse)(nil),                                    // 34: accord.RedeemInviteCodeResponse
+	(*OpenDirectChannelRequest)(nil),                                    // 35: accord.OpenDirectChannelRequest
+	(*OpenDirectChannelResponse)(nil),                                   // 36: accord.OpenDirectChannelResponse
+	(*Message)(nil),                                                     // 37: accord.Message
+	(*Reaction)(nil),                                                    // 38: accord.Reaction
+	(*GetMessagesRequest)(nil),                                          // 39: accord.GetMessagesRequest
+	(*GetMessagesResponse)(nil),                                         // 40: accord.GetMessagesResponse
+	(*GetThreadRequest)(nil),                                            // 41: accord.GetThreadRequest
+	(*GetThreadResponse)(nil),                                           // 42: accord.GetThreadResponse
+	(*SetPresenceRequest)(nil),                                          // 43: accord.SetPresenceRequest
+	(*SetPresenceResponse)(nil),                                         // 44: accord.SetPresenceResponse
+	(*ServerStreamRequest)(nil),                                         // 45: accord.ServerStreamRequest
+	(*ServerStreamResponse)(nil),                                        // 46: accord.ServerStreamResponse
+	(*ChannelConfigMessage)(nil),                                        // 47: accord.ChannelConfigMessage
+	(*EphemeralMessage)(nil),                                            // 48: accord.EphemeralMessage
+	(*ChannelStreamRequest)(nil),                                        // 49: accord.ChannelStreamRequest
+	(*ChannelStreamResponse)(nil),                                       // 50: accord.ChannelStreamResponse
+	(*GetChannelsResponse_ChannelMeta)(nil),                             // 51: accord.GetChannelsResponse.ChannelMeta
+	nil,                                                                 // 52: accord.GetChannelsResponse.ChannelMetasEntry
+	(*GetChannelResponse_User)(nil),                                     // 53: accord.GetChannelResponse.User
+	(*GetChannelResponse_ChannelInfo)(nil),                              // 54: accord.GetChannelResponse.ChannelInfo
+	nil,                                                                 // 55: accord.GetChannelResponse.ChannelInfo.UsersEntry
+	(*InviteCode_Redemption)(nil),                                       // 56: accord.InviteCode.Redemption
+	(*ServerStreamResponse_ChannelAction)(nil),                          // 57: accord.ServerStreamResponse.ChannelAction
+	(*ServerStreamResponse_AnyOtherServerConfigChange)(nil),             // 58: accord.ServerStreamResponse.AnyOtherServerConfigChange
+	(*ServerStreamResponse_ChannelAction_AddChannel)(nil),               // 59: accord.ServerStreamResponse.ChannelAction.AddChannel
+	(*ServerStreamResponse_ChannelAction_RemoveChannel)(nil),            // 60: accord.ServerStreamResponse.ChannelAction.RemoveChannel
+	(*ServerStreamResponse_ChannelAction_RenameChannel)(nil),            // 61: accord.ServerStreamResponse.ChannelAction.RenameChannel
+	(*ChannelConfigMessage_NameChannelConfigMessage)(nil),               // 62: accord.ChannelConfigMessage.NameChannelConfigMessage
+	(*ChannelConfigMessage_RoleChannelConfigMessage)(nil),               // 63: accord.ChannelConfigMessage.RoleChannelConfigMessage
+	(*ChannelConfigMessage_DefineRoleChannelConfigMessage)(nil),         // 64: accord.ChannelConfigMessage.DefineRoleChannelConfigMessage
+	(*ChannelConfigMessage_RemoveRoleChannelConfigMessage)(nil),         // 65: accord.ChannelConfigMessage.RemoveRoleChannelConfigMessage
+	(*ChannelConfigMessage_KickChannelConfigMessage)(nil),               // 66: accord.ChannelConfigMessage.KickChannelConfigMessage
+	(*ChannelConfigMessage_BanChannelConfigMessage)(nil),                // 67: accord.ChannelConfigMessage.BanChannelConfigMessage
+	(*ChannelConfigMessage_UnbanChannelConfigMessage)(nil),              // 68: accord.ChannelConfigMessage.UnbanChannelConfigMessage
+	(*ChannelConfigMessage_PinChannelConfigMessage)(nil),                // 69: accord.ChannelConfigMessage.PinChannelConfigMessage
+	(*ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage)(nil), // 70: accord.ChannelConfigMessage.InviteCodeRedeemedChannelConfigMessage
+	(*EphemeralMessage_TypingEphemeralMessage)(nil),                     // 71: accord.EphemeralMessage.TypingEphemeralMessage
+	(*EphemeralMessage_PresenceEphemeralMessage)(nil),                   // 72: accord.EphemeralMessage.PresenceEphemeralMessage
+	(*ChannelStreamRequest_SubscribeMessage)(nil),                       // 73: accord.ChannelStreamRequest.SubscribeMessage
+	(*ChannelStreamRequest_UserMessage)(nil),                            // 74: accord.ChannelStreamRequest.UserMessage
+	(*ChannelStreamRequest_UserMessage_NewUserMessage)(nil),             // 75: accord.ChannelStreamRequest.UserMessage.NewUserMessage
+	(*ChannelStreamRequest_UserMessage_EditUserMessage)(nil),            // 76: accord.ChannelStreamRequest.UserMessage.EditUserMessage
+	(*ChannelStreamRequest_UserMessage_DeleteUserMessage)(nil),          // 77: accord.ChannelStreamRequest.UserMessage.DeleteUserMessage
+	(*ChannelStreamRequest_UserMessage_AddReactionUserMessage)(nil),     // 78: accord.ChannelStreamRequest.UserMessage.AddReactionUserMessage
+	(*ChannelStreamRequest_UserMessage_RemoveReactionUserMessage)(nil),  // 79: accord.ChannelStreamRequest.UserMessage.RemoveReactionUserMessage
+	(*ChannelStreamRequest_UserMessage_MarkReadUserMessage)(nil),        // 80: accord.ChannelStreamRequest.UserMessage.MarkReadUserMessage
+	(*ChannelStreamResponse_ChannelRemovedMessage)(nil),                 // 81: accord.ChannelStreamResponse.ChannelRemovedMessage
+	(*ChannelStreamResponse_ErrorMessage)(nil),                          // 82: accord.ChannelStreamResponse.ErrorMessage
+	(*ChannelStreamResponse_UserMessage)(nil),                           // 83: accord.ChannelStreamResponse.UserMessage
+	(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage)(nil),   // 84: accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage
+	(*ChannelStreamResponse_UserMessage_DeleteUserMessage)(nil),         // 85: accord.ChannelStreamResponse.UserMessage.DeleteUserMessage
+	(*ChannelStreamResponse_UserMessage_ReactionsUserMessage)(nil),      // 86: accord.ChannelStreamResponse.UserMessage.ReactionsUserMessage
+	(*ChannelStreamResponse_UserMessage_ReadUserMessage)(nil),           // 87: accord.ChannelStreamResponse.UserMessage.ReadUserMessage
+	(*timestamp.Timestamp)(nil),                                         // 88: google.protobuf.Timestamp
+}
+var file_accord_proto_depIdxs = []int32{
+	2,   // 0: accord.Presence.status:type_name -> accord.PresenceStatus
+	0,   // 1: accord.RoleDefinition.permissions:type_name -> accord.Permission
+	88,  // 2: accord.Ban.banned_at:type_name -> google.protobuf.Timestamp
+	88,  // 3: accord.Ban.expires_at:type_name -> google.protobuf.Timestamp
+	52,  // 4: accord.GetChannelsResponse.channel_metas:type_name -> accord.GetChannelsResponse.ChannelMetasEntry
+	54,  // 5: accord.GetChannelResponse.channel:type_name -> accord.GetChannelResponse.ChannelInfo
+	1,   // 6: accord.Invite.role:type_name -> accord.Role
+	88,  // 7: accord.Invite.invited_at:type_name -> google.protobuf.Timestamp
+	1,   // 8: accord.JoinRequest.role:type_name -> accord.Role
+	88,  // 9: accord.JoinRequest.requested_at:type_name -> google.protobuf.Timestamp
+	1,   // 10: accord.InviteUserRequest.role:type_name -> accord.Role
+	14,  // 11: accord.GetInvitesResponse.invites:type_name -> accord.Invite
+	1,   // 12: accord.RequestToJoinRequest.role:type_name -> accord.Role
+	1,   // 13: accord.InviteCode.role:type_name -> accord.Role
+	88,  // 14: accord.InviteCode.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 15: accord.InviteCode.expires_at:type_name -> google.protobuf.Timestamp
+	56,  // 16: accord.InviteCode.redemptions:type_name -> accord.InviteCode.Redemption
+	1,   // 17: accord.CreateInviteCodeRequest.role:type_name -> accord.Role
+	88,  // 18: accord.CreateInviteCodeRequest.expires_at:type_name -> google.protobuf.Timestamp
+	26,  // 19: accord.CreateInviteCodeResponse.invite_code:type_name -> accord.InviteCode
+	26,  // 20: accord.GetInviteCodesResponse.invite_codes:type_name -> accord.InviteCode
+	88,  // 21: accord.Message.timestamp:type_name -> google.protobuf.Timestamp
+	88,  // 22: accord.Message.last_reply_at:type_name -> google.protobuf.Timestamp
+	38,  // 23: accord.Message.reactions:type_name -> accord.Reaction
+	37,  // 24: accord.GetMessagesResponse.messages:type_name -> accord.Message
+	37,  // 25: accord.GetThreadResponse.root:type_name -> accord.Message
+	37,  // 26: accord.GetThreadResponse.replies:type_name -> accord.Message
+	2,   // 27: accord.SetPresenceRequest.status:type_name -> accord.PresenceStatus
+	3,   // 28: accord.SetPresenceResponse.presence:type_name -> accord.Presence
+	57,  // 29: accord.ServerStreamResponse.channel_action:type_name -> accord.ServerStreamResponse.ChannelAction
+	58,  // 30: accord.ServerStreamResponse.any_other_server_config_change:type_name -> accord.ServerStreamResponse.AnyOtherServerConfigChange
+	62,  // 31: accord.ChannelConfigMessage.name_msg:type_name -> accord.ChannelConfigMessage.NameChannelConfigMessage
+	63,  // 32: accord.ChannelConfigMessage.role_msg:type_name -> accord.ChannelConfigMessage.RoleChannelConfigMessage
+	69,  // 33: accord.ChannelConfigMessage.pin_msg:type_name -> accord.ChannelConfigMessage.PinChannelConfigMessage
+	64,  // 34: accord.ChannelConfigMessage.define_role_msg:type_name -> accord.ChannelConfigMessage.DefineRoleChannelConfigMessage
+	65,  // 35: accord.ChannelConfigMessage.remove_role_msg:type_name -> accord.ChannelConfigMessage.RemoveRoleChannelConfigMessage
+	66,  // 36: accord.ChannelConfigMessage.kick_msg:type_name -> accord.ChannelConfigMessage.KickChannelConfigMessage
+	67,  // 37: accord.ChannelConfigMessage.ban_msg:type_name -> accord.ChannelConfigMessage.BanChannelConfigMessage
+	68,  // 38: accord.ChannelConfigMessage.unban_msg:type_name -> accord.ChannelConfigMessage.UnbanChannelConfigMessage
+	70,  // 39: accord.ChannelConfigMessage.invite_code_redeemed_msg:type_name -> accord.ChannelConfigMessage.InviteCodeRedeemedChannelConfigMessage
+	71,  // 40: accord.EphemeralMessage.typing_msg:type_name -> accord.EphemeralMessage.TypingEphemeralMessage
+	72,  // 41: accord.EphemeralMessage.presence_msg:type_name -> accord.EphemeralMessage.PresenceEphemeralMessage
+	74,  // 42: accord.ChannelStreamRequest.user_msg:type_name -> accord.ChannelStreamRequest.UserMessage
+	47,  // 43: accord.ChannelStreamRequest.config_msg:type_name -> accord.ChannelConfigMessage
+	48,  // 44: accord.ChannelStreamRequest.ephemeral_msg:type_name -> accord.EphemeralMessage
+	73,  // 45: accord.ChannelStreamRequest.subscribe_msg:type_name -> accord.ChannelStreamRequest.SubscribeMessage
+	83,  // 46: accord.ChannelStreamResponse.user_msg:type_name -> accord.ChannelStreamResponse.UserMessage
+	47,  // 47: accord.ChannelStreamResponse.config_msg:type_name -> accord.ChannelConfigMessage
+	48,  // 48: accord.ChannelStreamResponse.ephemeral_msg:type_name -> accord.EphemeralMessage
+	81,  // 49: accord.ChannelStreamResponse.channel_removed_msg:type_name -> accord.ChannelStreamResponse.ChannelRemovedMessage
+	82,  // 50: accord.ChannelStreamResponse.error_msg:type_name -> accord.ChannelStreamResponse.ErrorMessage
+	51,  // 51: accord.GetChannelsResponse.ChannelMetasEntry.value:type_name -> accord.GetChannelsResponse.ChannelMeta
+	3,   // 52: accord.GetChannelResponse.User.presence:type_name -> accord.Presence
+	55,  // 53: accord.GetChannelResponse.ChannelInfo.users:type_name -> accord.GetChannelResponse.ChannelInfo.UsersEntry
+	4,   // 54: accord.GetChannelResponse.ChannelInfo.roles:type_name -> accord.RoleDefinition
+	5,   // 55: accord.GetChannelResponse.ChannelInfo.bans:type_name -> accord.Ban
+	14,  // 56: accord.GetChannelResponse.ChannelInfo.invites:type_name -> accord.Invite
+	15,  // 57: accord.GetChannelResponse.ChannelInfo.join_requests:type_name -> accord.JoinRequest
+	53,  // 58: accord.GetChannelResponse.ChannelInfo.UsersEntry.value:type_name -> accord.GetChannelResponse.User
+	88,  // 59: accord.InviteCode.Redemption.redeemed_at:type_name -> google.protobuf.Timestamp
+	59,  // 60: accord.ServerStreamResponse.ChannelAction.add_channel:type_name -> accord.ServerStreamResponse.ChannelAction.AddChannel
+	60,  // 61: accord.ServerStreamResponse.ChannelAction.remove_channel:type_name -> accord.ServerStreamResponse.ChannelAction.RemoveChannel
+	61,  // 62: accord.ServerStreamResponse.ChannelAction.rename_channel:type_name -> accord.ServerStreamResponse.ChannelAction.RenameChannel
+	1,   // 63: accord.ChannelConfigMessage.RoleChannelConfigMessage.role:type_name -> accord.Role
+	4,   // 64: accord.ChannelConfigMessage.DefineRoleChannelConfigMessage.role:type_name -> accord.RoleDefinition
+	5,   // 65: accord.ChannelConfigMessage.BanChannelConfigMessage.ban:type_name -> accord.Ban
+	88,  // 66: accord.ChannelConfigMessage.InviteCodeRedeemedChannelConfigMessage.redeemed_at:type_name -> google.protobuf.Timestamp
+	88,  // 67: accord.EphemeralMessage.TypingEphemeralMessage.expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 68: accord.EphemeralMessage.PresenceEphemeralMessage.presence:type_name -> accord.Presence
+	75,  // 69: accord.ChannelStreamRequest.UserMessage.new_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.NewUserMessage
+	76,  // 70: accord.ChannelStreamRequest.UserMessage.edit_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.EditUserMessage
+	77,  // 71: accord.ChannelStreamRequest.UserMessage.delete_user_msg:type_name -> accord.ChannelStreamRequest.UserMessage.DeleteUserMessage
+	78,  // 72: accord.ChannelStreamRequest.UserMessage.add_reaction_msg:type_name -> accord.ChannelStreamRequest.UserMessage.AddReactionUserMessage
+	79,  // 73: accord.ChannelStreamRequest.UserMessage.remove_reaction_msg:type_name -> accord.ChannelStreamRequest.UserMessage.RemoveReactionUserMessage
+	80,  // 74: accord.ChannelStreamRequest.UserMessage.mark_read_msg:type_name -> accord.ChannelStreamRequest.UserMessage.MarkReadUserMessage
+	84,  // 75: accord.ChannelStreamResponse.UserMessage.new_and_update_user_msg:type_name -> accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage
+	85,  // 76: accord.ChannelStreamResponse.UserMessage.delete_user_msg:type_name -> accord.ChannelStreamResponse.UserMessage.DeleteUserMessage
+	86,  // 77: accord.ChannelStreamResponse.UserMessage.reactions_msg:type_name -> accord.ChannelStreamResponse.UserMessage.ReactionsUserMessage
+	87,  // 78: accord.ChannelStreamResponse.UserMessage.read_msg:type_name -> accord.ChannelStreamResponse.UserMessage.ReadUserMessage
+	88,  // 79: accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage.timestamp:type_name -> google.protobuf.Timestamp
+	88,  // 80: accord.ChannelStreamResponse.UserMessage.NewAndUpdateUserMessage.last_reply_at:type_name -> google.protobuf.Timestamp
+	38,  // 81: accord.ChannelStreamResponse.UserMessage.ReactionsUserMessage.reactions:type_name -> accord.Reaction
+	6,   // 82: accord.Chat.AddChannel:input_type -> accord.AddChannelRequest
+	8,   // 83: accord.Chat.RemoveChannel:input_type -> accord.RemoveChannelRequest
+	10,  // 84: accord.Chat.GetChannels:input_type -> accord.GetChannelsRequest
+	12,  // 85: accord.Chat.GetChannel:input_type -> accord.GetChannelRequest
+	35,  // 86: accord.Chat.OpenDirectChannel:input_type -> accord.OpenDirectChannelRequest
+	39,  // 87: accord.Chat.GetMessages:input_type -> accord.GetMessagesRequest
+	41,  // 88: accord.Chat.GetThread:input_type -> accord.GetThreadRequest
+	43,  // 89: accord.Chat.SetPresence:input_type -> accord.SetPresenceRequest
+	16,  // 90: accord.Chat.InviteUser:input_type -> accord.InviteUserRequest
+	18,  // 91: accord.Chat.GetInvites:input_type -> accord.GetInvitesRequest
+	20,  // 92: accord.Chat.RespondToInvite:input_type -> accord.RespondToInviteRequest
+	22,  // 93: accord.Chat.RequestToJoin:input_type -> accord.RequestToJoinRequest
+	24,  // 94: accord.Chat.ReviewJoinRequest:input_type -> accord.ReviewJoinRequestRequest
+	27,  // 95: accord.Chat.CreateInviteCode:input_type -> accord.CreateInviteCodeRequest
+	29,  // 96: accord.Chat.GetInviteCodes:input_type -> accord.GetInviteCodesRequest
+	31,  // 97: accord.Chat.RevokeInviteCode:input_type -> accord.RevokeInviteCodeRequest
+	33,  // 98: accord.Chat.RedeemInviteCode:input_type -> accord.RedeemInviteCodeRequest
+	45,  // 99: accord.Chat.ServerStream:input_type -> accord.ServerStreamRequest
+	49,  // 100: accord.Chat.ChannelStream:input_type -> accord.ChannelStreamRequest
+	7,   // 101: accord.Chat.AddChannel:output_type -> accord.AddChannelResponse
+	9,   // 102: accord.Chat.RemoveChannel:output_type -> accord.RemoveChannelResponse
+	11,  // 103: accord.Chat.GetChannels:output_type -> accord.GetChannelsResponse
+	13,  // 104: accord.Chat.GetChannel:output_type -> accord.GetChannelResponse
+	36,  // 105: accord.Chat.OpenDirectChannel:output_type -> accord.OpenDirectChannelResponse
+	40,  // 106: accord.Chat.GetMessages:output_type -> accord.GetMessagesResponse
+	42,  // 107: accord.Chat.GetThread:output_type -> accord.GetThreadResponse
+	44,  // 108: accord.Chat.SetPresence:output_type -> accord.SetPresenceResponse
+	17,  // 109: accord.Chat.InviteUser:output_type -> accord.InviteUserResponse
+	19,  // 110: accord.Chat.GetInvites:output_type -> accord.GetInvitesResponse
+	21,  // 111: accord.Chat.RespondToInvite:output_type -> accord.RespondToInviteResponse
+	23,  // 112: accord.Chat.RequestToJoin:output_type -> accord.RequestToJoinResponse
+	25,  // 113: accord.Chat.ReviewJoinRequest:output_type -> accord.ReviewJoinRequestResponse
+	28,  // 114: accord.Chat.CreateInviteCode:output_type -> accord.CreateInviteCodeResponse
+	30,  // 115: accord.Chat.GetInviteCodes:output_type -> accord.GetInviteCodesResponse
+	32,  // 116: accord.Chat.RevokeInviteCode:output_type -> accord.RevokeInviteCodeResponse
+	34,  // 117: accord.Chat.RedeemInviteCode:output_type -> accord.RedeemInviteCodeResponse
+	46,  // 118: accord.Chat.ServerStream:output_type -> accord.ServerStreamResponse
+	50,  // 119: accord.Chat.ChannelStream:output_type -> accord.ChannelStreamResponse
+	101, // [101:120] is the sub-list for method output_type
+	82,  // [82:101] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
+}
+
+func init() { file_accord_proto_init() }
+func file_accord_proto_init() {
+	if File_accord_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_accord_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Presence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ban); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondToInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondToInviteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestToJoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestToJoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewJoinRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewJoinRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInviteCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInviteCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemInviteCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemInviteCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDirectChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDirectChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPresenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPresenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemeralMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelsResponse_ChannelMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1901,8 +7120,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddChannelResponse); i {
+		file_accord_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelResponse_User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1913,8 +7132,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChannelRequest); i {
+		file_accord_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelResponse_ChannelInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1925,8 +7144,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChannelResponse); i {
+		file_accord_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCode_Redemption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,8 +7156,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelsRequest); i {
+		file_accord_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse_ChannelAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1949,8 +7168,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelsResponse); i {
+		file_accord_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse_AnyOtherServerConfigChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,8 +7180,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelRequest); i {
+		file_accord_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse_ChannelAction_AddChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,8 +7192,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelResponse); i {
+		file_accord_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse_ChannelAction_RemoveChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,8 +7204,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelConfigMessage); i {
+		file_accord_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStreamResponse_ChannelAction_RenameChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,8 +7216,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelStreamRequest); i {
+		file_accord_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_NameChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,8 +7228,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelStreamResponse); i {
+		file_accord_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_RoleChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,8 +7240,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelsResponse_ChannelMeta); i {
+		file_accord_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_DefineRoleChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2033,8 +7252,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelResponse_User); i {
+		file_accord_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_RemoveRoleChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2045,8 +7264,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChannelResponse_ChannelInfo); i {
+		file_accord_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_KickChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2057,8 +7276,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelConfigMessage_NameChannelConfigMessage); i {
+		file_accord_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_BanChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2069,8 +7288,8 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelConfigMessage_RoleChannelConfigMessage); i {
+		file_accord_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_UnbanChannelConfigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2081,7 +7300,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelConfigMessage_PinChannelConfigMessage); i {
 			case 0:
 				return &v.state
@@ -2093,7 +7312,55 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemeralMessage_TypingEphemeralMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemeralMessage_PresenceEphemeralMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamRequest_SubscribeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamRequest_UserMessage); i {
 			case 0:
 				return &v.state
@@ -2105,7 +7372,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamRequest_UserMessage_NewUserMessage); i {
 			case 0:
 				return &v.state
@@ -2117,7 +7384,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamRequest_UserMessage_EditUserMessage); i {
 			case 0:
 				return &v.state
@@ -2129,7 +7396,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamRequest_UserMessage_DeleteUserMessage); i {
 			case 0:
 				return &v.state
@@ -2141,7 +7408,67 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamRequest_UserMessage_AddReactionUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamRequest_UserMessage_RemoveReactionUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamRequest_UserMessage_MarkReadUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamResponse_ChannelRemovedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamResponse_ErrorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamResponse_UserMessage); i {
 			case 0:
 				return &v.state
@@ -2153,7 +7480,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage); i {
 			case 0:
 				return &v.state
@@ -2165,7 +7492,7 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
-		file_accord_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_accord_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelStreamResponse_UserMessage_DeleteUserMessage); i {
 			case 0:
 				return &v.state
@@ -2177,36 +7504,89 @@ func file_accord_proto_init() {
 				return nil
 			}
 		}
+		file_accord_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamResponse_UserMessage_ReactionsUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accord_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelStreamResponse_UserMessage_ReadUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_accord_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*ServerStreamResponse_ChannelAction_)(nil),
+		(*ServerStreamResponse_AnyOtherServerConfigChange_)(nil),
 	}
-	file_accord_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_accord_proto_msgTypes[44].OneofWrappers = []interface{}{
 		(*ChannelConfigMessage_NameMsg)(nil),
 		(*ChannelConfigMessage_RoleMsg)(nil),
 		(*ChannelConfigMessage_PinMsg)(nil),
-	}
-	file_accord_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*ChannelConfigMessage_DefineRoleMsg)(nil),
+		(*ChannelConfigMessage_RemoveRoleMsg)(nil),
+		(*ChannelConfigMessage_KickMsg)(nil),
+		(*ChannelConfigMessage_BanMsg)(nil),
+		(*ChannelConfigMessage_UnbanMsg)(nil),
+		(*ChannelConfigMessage_InviteCodeRedeemedMsg)(nil),
+	}
+	file_accord_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*EphemeralMessage_TypingMsg)(nil),
+		(*EphemeralMessage_PresenceMsg)(nil),
+	}
+	file_accord_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*ChannelStreamRequest_UserMsg)(nil),
 		(*ChannelStreamRequest_ConfigMsg)(nil),
+		(*ChannelStreamRequest_EphemeralMsg)(nil),
+		(*ChannelStreamRequest_SubscribeMsg)(nil),
 	}
-	file_accord_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_accord_proto_msgTypes[47].OneofWrappers = []interface{}{
 		(*ChannelStreamResponse_UserMsg)(nil),
 		(*ChannelStreamResponse_ConfigMsg)(nil),
+		(*ChannelStreamResponse_EphemeralMsg)(nil),
+		(*ChannelStreamResponse_ChannelRemovedMsg)(nil),
+		(*ChannelStreamResponse_ErrorMsg)(nil),
+	}
+	file_accord_proto_msgTypes[54].OneofWrappers = []interface{}{
+		(*ServerStreamResponse_ChannelAction_AddChannel_)(nil),
+		(*ServerStreamResponse_ChannelAction_RemoveChannel_)(nil),
+		(*ServerStreamResponse_ChannelAction_RenameChannel_)(nil),
 	}
-	file_accord_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_accord_proto_msgTypes[71].OneofWrappers = []interface{}{
 		(*ChannelStreamRequest_UserMessage_NewUserMsg)(nil),
 		(*ChannelStreamRequest_UserMessage_EditUserMsg)(nil),
 		(*ChannelStreamRequest_UserMessage_DeleteUserMsg)(nil),
+		(*ChannelStreamRequest_UserMessage_AddReactionMsg)(nil),
+		(*ChannelStreamRequest_UserMessage_RemoveReactionMsg)(nil),
+		(*ChannelStreamRequest_UserMessage_MarkReadMsg)(nil),
 	}
-	file_accord_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_accord_proto_msgTypes[80].OneofWrappers = []interface{}{
 		(*ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg)(nil),
 		(*ChannelStreamResponse_UserMessage_DeleteUserMsg)(nil),
+		(*ChannelStreamResponse_UserMessage_ReactionsMsg)(nil),
+		(*ChannelStreamResponse_UserMessage_ReadMsg)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_accord_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   26,
+			NumEnums:      3,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2237,6 +7617,31 @@ type ChatClient interface {
 	RemoveChannel(ctx context.Context, in *RemoveChannelRequest, opts ...grpc.CallOption) (*RemoveChannelResponse, error)
 	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
 	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error)
+	// Returns the direct channel of the caller and the users, creating it if
+	// needed, so calling it again with the same users gives the same channel.
+	OpenDirectChannel(ctx context.Context, in *OpenDirectChannelRequest, opts ...grpc.CallOption) (*OpenDirectChannelResponse, error)
+	// Returns one page of the channel's message history.
+	GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	// Returns one page of replies in the thread.
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error)
+	SetPresence(ctx context.Context, in *SetPresenceRequest, opts ...grpc.CallOption) (*SetPresenceResponse, error)
+	// Users join channels either by accepting invites or by requests to join,
+	// which are approved by users who can assign roles. Streaming with a
+	// channel doesn't make the user its member.
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error)
+	GetInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error)
+	RespondToInvite(ctx context.Context, in *RespondToInviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error)
+	RequestToJoin(ctx context.Context, in *RequestToJoinRequest, opts ...grpc.CallOption) (*RequestToJoinResponse, error)
+	ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*ReviewJoinRequestResponse, error)
+	// Invite codes are managed by users who can assign roles, and they can be
+	// redeemed by any user.
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error)
+	GetInviteCodes(ctx context.Context, in *GetInviteCodesRequest, opts ...grpc.CallOption) (*GetInviteCodesResponse, error)
+	RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error)
+	RedeemInviteCode(ctx context.Context, in *RedeemInviteCodeRequest, opts ...grpc.CallOption) (*RedeemInviteCodeResponse, error)
+	// Stream's server-scope information to user, such as addition or
+	// removal of channels, and change in other server configurations.
+	ServerStream(ctx context.Context, in *ServerStreamRequest, opts ...grpc.CallOption) (Chat_ServerStreamClient, error)
 	// Bidirectional stream of user and channel configuration messages
 	// with a single channel.
 	// NOTE: the fields and nested messages were designed with a single
@@ -2255,44 +7660,193 @@ func NewChatClient(cc grpc.ClientConnInterface) ChatClient {
 	return &chatClient{cc}
 }
 
-func (c *chatClient) AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*AddChannelResponse, error) {
-	out := new(AddChannelResponse)
-	err := c.cc.Invoke(ctx, "/accord.Chat/AddChannel", in, out, opts...)
+func (c *chatClient) AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*AddChannelResponse, error) {
+	out := new(AddChannelResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/AddChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RemoveChannel(ctx context.Context, in *RemoveChannelRequest, opts ...grpc.CallOption) (*RemoveChannelResponse, error) {
+	out := new(RemoveChannelResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/RemoveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
+	out := new(GetChannelsResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
+	out := new(GetChannelResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) OpenDirectChannel(ctx context.Context, in *OpenDirectChannelRequest, opts ...grpc.CallOption) (*OpenDirectChannelResponse, error) {
+	out := new(OpenDirectChannelResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/OpenDirectChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error) {
+	out := new(GetThreadResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SetPresence(ctx context.Context, in *SetPresenceRequest, opts ...grpc.CallOption) (*SetPresenceResponse, error) {
+	out := new(SetPresenceResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/SetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
+	out := new(InviteUserResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/InviteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
+	out := new(GetInvitesResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RespondToInvite(ctx context.Context, in *RespondToInviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error) {
+	out := new(RespondToInviteResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/RespondToInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RequestToJoin(ctx context.Context, in *RequestToJoinRequest, opts ...grpc.CallOption) (*RequestToJoinResponse, error) {
+	out := new(RequestToJoinResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/RequestToJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ReviewJoinRequest(ctx context.Context, in *ReviewJoinRequestRequest, opts ...grpc.CallOption) (*ReviewJoinRequestResponse, error) {
+	out := new(ReviewJoinRequestResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/ReviewJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error) {
+	out := new(CreateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/CreateInviteCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetInviteCodes(ctx context.Context, in *GetInviteCodesRequest, opts ...grpc.CallOption) (*GetInviteCodesResponse, error) {
+	out := new(GetInviteCodesResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/GetInviteCodes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) RemoveChannel(ctx context.Context, in *RemoveChannelRequest, opts ...grpc.CallOption) (*RemoveChannelResponse, error) {
-	out := new(RemoveChannelResponse)
-	err := c.cc.Invoke(ctx, "/accord.Chat/RemoveChannel", in, out, opts...)
+func (c *chatClient) RevokeInviteCode(ctx context.Context, in *RevokeInviteCodeRequest, opts ...grpc.CallOption) (*RevokeInviteCodeResponse, error) {
+	out := new(RevokeInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/RevokeInviteCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
-	out := new(GetChannelsResponse)
-	err := c.cc.Invoke(ctx, "/accord.Chat/GetChannels", in, out, opts...)
+func (c *chatClient) RedeemInviteCode(ctx context.Context, in *RedeemInviteCodeRequest, opts ...grpc.CallOption) (*RedeemInviteCodeResponse, error) {
+	out := new(RedeemInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/accord.Chat/RedeemInviteCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
-	out := new(GetChannelResponse)
-	err := c.cc.Invoke(ctx, "/accord.Chat/GetChannel", in, out, opts...)
+func (c *chatClient) ServerStream(ctx context.Context, in *ServerStreamRequest, opts ...grpc.CallOption) (Chat_ServerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Chat_serviceDesc.Streams[0], "/accord.Chat/ServerStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &chatServerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chat_ServerStreamClient interface {
+	Recv() (*ServerStreamResponse, error)
+	grpc.ClientStream
+}
+
+type chatServerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerStreamClient) Recv() (*ServerStreamResponse, error) {
+	m := new(ServerStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *chatClient) ChannelStream(ctx context.Context, opts ...grpc.CallOption) (Chat_ChannelStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Chat_serviceDesc.Streams[0], "/accord.Chat/ChannelStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Chat_serviceDesc.Streams[1], "/accord.Chat/ChannelStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2328,6 +7882,31 @@ type ChatServer interface {
 	RemoveChannel(context.Context, *RemoveChannelRequest) (*RemoveChannelResponse, error)
 	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
 	GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error)
+	// Returns the direct channel of the caller and the users, creating it if
+	// needed, so calling it again with the same users gives the same channel.
+	OpenDirectChannel(context.Context, *OpenDirectChannelRequest) (*OpenDirectChannelResponse, error)
+	// Returns one page of the channel's message history.
+	GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error)
+	// Returns one page of replies in the thread.
+	GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error)
+	SetPresence(context.Context, *SetPresenceRequest) (*SetPresenceResponse, error)
+	// Users join channels either by accepting invites or by requests to join,
+	// which are approved by users who can assign roles. Streaming with a
+	// channel doesn't make the user its member.
+	InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error)
+	GetInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error)
+	RespondToInvite(context.Context, *RespondToInviteRequest) (*RespondToInviteResponse, error)
+	RequestToJoin(context.Context, *RequestToJoinRequest) (*RequestToJoinResponse, error)
+	ReviewJoinRequest(context.Context, *ReviewJoinRequestRequest) (*ReviewJoinRequestResponse, error)
+	// Invite codes are managed by users who can assign roles, and they can be
+	// redeemed by any user.
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error)
+	GetInviteCodes(context.Context, *GetInviteCodesRequest) (*GetInviteCodesResponse, error)
+	RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error)
+	RedeemInviteCode(context.Context, *RedeemInviteCodeRequest) (*RedeemInviteCodeResponse, error)
+	// Stream's server-scope information to user, such as addition or
+	// removal of channels, and change in other server configurations.
+	ServerStream(*ServerStreamRequest, Chat_ServerStreamServer) error
 	// Bidirectional stream of user and channel configuration messages
 	// with a single channel.
 	// NOTE: the fields and nested messages were designed with a single
@@ -2354,6 +7933,48 @@ func (*UnimplementedChatServer) GetChannels(context.Context, *GetChannelsRequest
 func (*UnimplementedChatServer) GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
 }
+func (*UnimplementedChatServer) OpenDirectChannel(context.Context, *OpenDirectChannelRequest) (*OpenDirectChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDirectChannel not implemented")
+}
+func (*UnimplementedChatServer) GetMessages(context.Context, *GetMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (*UnimplementedChatServer) GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (*UnimplementedChatServer) SetPresence(context.Context, *SetPresenceRequest) (*SetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPresence not implemented")
+}
+func (*UnimplementedChatServer) InviteUser(context.Context, *InviteUserRequest) (*InviteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (*UnimplementedChatServer) GetInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvites not implemented")
+}
+func (*UnimplementedChatServer) RespondToInvite(context.Context, *RespondToInviteRequest) (*RespondToInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToInvite not implemented")
+}
+func (*UnimplementedChatServer) RequestToJoin(context.Context, *RequestToJoinRequest) (*RequestToJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestToJoin not implemented")
+}
+func (*UnimplementedChatServer) ReviewJoinRequest(context.Context, *ReviewJoinRequestRequest) (*ReviewJoinRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewJoinRequest not implemented")
+}
+func (*UnimplementedChatServer) CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (*UnimplementedChatServer) GetInviteCodes(context.Context, *GetInviteCodesRequest) (*GetInviteCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteCodes not implemented")
+}
+func (*UnimplementedChatServer) RevokeInviteCode(context.Context, *RevokeInviteCodeRequest) (*RevokeInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInviteCode not implemented")
+}
+func (*UnimplementedChatServer) RedeemInviteCode(context.Context, *RedeemInviteCodeRequest) (*RedeemInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInviteCode not implemented")
+}
+func (*UnimplementedChatServer) ServerStream(*ServerStreamRequest, Chat_ServerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ServerStream not implemented")
+}
 func (*UnimplementedChatServer) ChannelStream(Chat_ChannelStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChannelStream not implemented")
 }
@@ -2434,6 +8055,261 @@ func _Chat_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_OpenDirectChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDirectChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).OpenDirectChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/OpenDirectChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).OpenDirectChannel(ctx, req.(*OpenDirectChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/GetMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetMessages(ctx, req.(*GetMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/GetThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/SetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SetPresence(ctx, req.(*SetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/InviteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).InviteUser(ctx, req.(*InviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/GetInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetInvites(ctx, req.(*GetInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RespondToInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RespondToInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/RespondToInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RespondToInvite(ctx, req.(*RespondToInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RequestToJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestToJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RequestToJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/RequestToJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RequestToJoin(ctx, req.(*RequestToJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ReviewJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ReviewJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/ReviewJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ReviewJoinRequest(ctx, req.(*ReviewJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/CreateInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetInviteCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetInviteCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/GetInviteCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetInviteCodes(ctx, req.(*GetInviteCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RevokeInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RevokeInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/RevokeInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RevokeInviteCode(ctx, req.(*RevokeInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RedeemInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RedeemInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accord.Chat/RedeemInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RedeemInviteCode(ctx, req.(*RedeemInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ServerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServer).ServerStream(m, &chatServerStreamServer{stream})
+}
+
+type Chat_ServerStreamServer interface {
+	Send(*ServerStreamResponse) error
+	grpc.ServerStream
+}
+
+type chatServerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerStreamServer) Send(m *ServerStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Chat_ChannelStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ChatServer).ChannelStream(&chatChannelStreamServer{stream})
 }
@@ -2480,8 +8356,65 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetChannel",
 			Handler:    _Chat_GetChannel_Handler,
 		},
+		{
+			MethodName: "OpenDirectChannel",
+			Handler:    _Chat_OpenDirectChannel_Handler,
+		},
+		{
+			MethodName: "GetMessages",
+			Handler:    _Chat_GetMessages_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _Chat_GetThread_Handler,
+		},
+		{
+			MethodName: "SetPresence",
+			Handler:    _Chat_SetPresence_Handler,
+		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _Chat_InviteUser_Handler,
+		},
+		{
+			MethodName: "GetInvites",
+			Handler:    _Chat_GetInvites_Handler,
+		},
+		{
+			MethodName: "RespondToInvite",
+			Handler:    _Chat_RespondToInvite_Handler,
+		},
+		{
+			MethodName: "RequestToJoin",
+			Handler:    _Chat_RequestToJoin_Handler,
+		},
+		{
+			MethodName: "ReviewJoinRequest",
+			Handler:    _Chat_ReviewJoinRequest_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _Chat_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "GetInviteCodes",
+			Handler:    _Chat_GetInviteCodes_Handler,
+		},
+		{
+			MethodName: "RevokeInviteCode",
+			Handler:    _Chat_RevokeInviteCode_Handler,
+		},
+		{
+			MethodName: "RedeemInviteCode",
+			Handler:    _Chat_RedeemInviteCode_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ServerStream",
+			Handler:       _Chat_ServerStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ChannelStream",
 			Handler:       _Chat_ChannelStream_Handler,
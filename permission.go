@@ -1,6 +1,11 @@
 package accord
 
-import pb "github.com/qvntm/accord/pb"
+import (
+	"fmt"
+	"reflect"
+
+	pb "github.com/qvntm/accord/pb"
+)
 
 // Permission represents actions allowed to the role within a channel.
 type Permission int
@@ -41,3 +46,83 @@ var AccordToPBPermissions = map[Permission]pb.Permission{
 	AssignRolePermission:    pb.Permission_ASSIGN_ROLE,
 	RemoveChannelPermission: pb.Permission_REMOVE_CHANNEL,
 }
+
+// PBToAccordPermissions is a mapping from objects of "Permission" type of "pb"
+// package to the objects from this package.
+var PBToAccordPermissions = map[pb.Permission]Permission{
+	pb.Permission_UNKNOWN_PERMISSION: UnknownPermission,
+	pb.Permission_READ:               ReadPermission,
+	pb.Permission_WRITE:              WritePermission,
+	pb.Permission_DELETE:             DeletePermission,
+	pb.Permission_MODIFY:             ModifyPermission,
+	pb.Permission_KICK:               KickPermission,
+	pb.Permission_BAN:                BanPermission,
+	pb.Permission_ASSIGN_ROLE:        AssignRolePermission,
+	pb.Permission_REMOVE_CHANNEL:     RemoveChannelPermission,
+}
+
+// defaultRolesWithPermission is the mapping from permissions to roles, which
+// have them, in newly created channels.
+var defaultRolesWithPermission = map[Permission][]Role{
+	ReadPermission:          {SubscriberRole, MemberRole, AdminRole, SuperadminRole},
+	WritePermission:         {MemberRole, AdminRole, SuperadminRole},
+	DeletePermission:        {AdminRole, SuperadminRole},
+	KickPermission:          {AdminRole, SuperadminRole},
+	ModifyPermission:        {SuperadminRole},
+	BanPermission:           {SuperadminRole},
+	AssignRolePermission:    {SuperadminRole},
+	RemoveChannelPermission: {SuperadminRole},
+}
+
+// channelStreamRequestPermission returns the permission, which the user needs
+// to have in the channel to make the request.
+func channelStreamRequestPermission(req *pb.ChannelStreamRequest) (Permission, error) {
+	switch req.GetMsg().(type) {
+	case *pb.ChannelStreamRequest_UserMsg:
+		switch req.GetUserMsg().GetUserMsg().(type) {
+		case *pb.ChannelStreamRequest_UserMessage_NewUserMsg:
+			return WritePermission, nil
+		case *pb.ChannelStreamRequest_UserMessage_EditUserMsg:
+			return WritePermission, nil
+		case *pb.ChannelStreamRequest_UserMessage_DeleteUserMsg:
+			// messages of others also require DeletePermission
+			return WritePermission, nil
+		case *pb.ChannelStreamRequest_UserMessage_AddReactionMsg, *pb.ChannelStreamRequest_UserMessage_RemoveReactionMsg:
+			return WritePermission, nil
+		case *pb.ChannelStreamRequest_UserMessage_MarkReadMsg:
+			return ReadPermission, nil
+		}
+		return UnknownPermission, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(req.GetUserMsg().GetUserMsg()))
+	case *pb.ChannelStreamRequest_ConfigMsg:
+		switch req.GetConfigMsg().GetMsg().(type) {
+		case *pb.ChannelConfigMessage_NameMsg:
+			return ModifyPermission, nil
+		case *pb.ChannelConfigMessage_RoleMsg:
+			return AssignRolePermission, nil
+		case *pb.ChannelConfigMessage_PinMsg:
+			return ModifyPermission, nil
+		case *pb.ChannelConfigMessage_DefineRoleMsg:
+			return AssignRolePermission, nil
+		case *pb.ChannelConfigMessage_RemoveRoleMsg:
+			return AssignRolePermission, nil
+		case *pb.ChannelConfigMessage_KickMsg:
+			return KickPermission, nil
+		case *pb.ChannelConfigMessage_BanMsg:
+			return BanPermission, nil
+		case *pb.ChannelConfigMessage_UnbanMsg:
+			return BanPermission, nil
+		case *pb.ChannelConfigMessage_InviteCodeRedeemedMsg:
+			return UnknownPermission, fmt.Errorf("redemptions of invite codes can only be sent by the server")
+		}
+		return UnknownPermission, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(req.GetConfigMsg().GetMsg()))
+	case *pb.ChannelStreamRequest_EphemeralMsg:
+		switch req.GetEphemeralMsg().GetMsg().(type) {
+		case *pb.EphemeralMessage_TypingMsg:
+			return WritePermission, nil
+		case *pb.EphemeralMessage_PresenceMsg:
+			return UnknownPermission, fmt.Errorf("presence can only be sent by the server")
+		}
+		return UnknownPermission, fmt.Errorf("Invalid object type: %v", reflect.TypeOf(req.GetEphemeralMsg().GetMsg()))
+	}
+	return UnknownPermission, fmt.Errorf("Invalid request type: %v", reflect.TypeOf(req.GetMsg()))
+}
@@ -0,0 +1,163 @@
+package accord
+
+import (
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	pb "github.com/qvntm/accord/pb"
+)
+
+// maxCustomStatusLength is the maximal length of custom status text in runes.
+const maxCustomStatusLength = 128
+
+// PresenceStatus is the presence of a user across all of the user's connections.
+type PresenceStatus int
+
+const (
+	OfflinePresenceStatus PresenceStatus = iota
+	OnlinePresenceStatus
+	IdlePresenceStatus
+)
+
+var AccordToPBPresenceStatuses = map[PresenceStatus]pb.PresenceStatus{
+	OfflinePresenceStatus: pb.PresenceStatus_OFFLINE,
+	OnlinePresenceStatus:  pb.PresenceStatus_ONLINE,
+	IdlePresenceStatus:    pb.PresenceStatus_IDLE,
+}
+
+var PBToAccordPresenceStatuses = map[pb.PresenceStatus]PresenceStatus{
+	pb.PresenceStatus_OFFLINE: OfflinePresenceStatus,
+	pb.PresenceStatus_ONLINE:  OnlinePresenceStatus,
+	pb.PresenceStatus_IDLE:    IdlePresenceStatus,
+}
+
+// Presence is the status of the user and the text set by the user.
+type Presence struct {
+	Status       PresenceStatus
+	CustomStatus string
+}
+
+// userPresence is what is known about the presence of a single user.
+type userPresence struct {
+	// connections is the number of streams, which the user has open
+	connections int
+	// idle is set by the user, and it is reset when the user disconnects
+	idle         bool
+	customStatus string
+}
+
+func (p *userPresence) presence() Presence {
+	status := OfflinePresenceStatus
+	if p.connections > 0 {
+		status = OnlinePresenceStatus
+		if p.idle {
+			status = IdlePresenceStatus
+		}
+	}
+	return Presence{
+		Status:       status,
+		CustomStatus: p.customStatus,
+	}
+}
+
+// presenceRegistry tracks presence of users across all of their streams, so
+// users with several devices stay online until they disconnect all of them.
+type presenceRegistry struct {
+	// mutex is also held while changes are reported, so that they are
+	// reported in the order they happen
+	mutex sync.Mutex
+	users map[string]*userPresence
+	// onChange is called with the new presence of the user whenever it changes
+	onChange func(username string, presence Presence)
+}
+
+func newPresenceRegistry(onChange func(username string, presence Presence)) *presenceRegistry {
+	return &presenceRegistry{
+		users:    make(map[string]*userPresence),
+		onChange: onChange,
+	}
+}
+
+// get returns the current presence of the user.
+func (r *presenceRegistry) get(username string) Presence {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if p, ok := r.users[username]; ok {
+		return p.presence()
+	}
+	return Presence{Status: OfflinePresenceStatus}
+}
+
+// connect is called when the user opens a stream.
+func (r *presenceRegistry) connect(username string) {
+	r.update(username, func(p *userPresence) {
+		p.connections++
+	})
+}
+
+// disconnect is called when the stream of the user, which has been opened
+// with connect, is closed.
+func (r *presenceRegistry) disconnect(username string) {
+	r.update(username, func(p *userPresence) {
+		if p.connections--; p.connections == 0 {
+			p.idle = false
+		}
+	})
+}
+
+// set changes the status and the custom status of the user. Only online and
+// idle statuses can be set, and users are idle only until they disconnect.
+func (r *presenceRegistry) set(username string, idle bool, customStatus string) Presence {
+	return r.update(username, func(p *userPresence) {
+		p.idle = idle && p.connections > 0
+		p.customStatus = customStatus
+	})
+}
+
+func (r *presenceRegistry) update(username string, f func(p *userPresence)) Presence {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	p, ok := r.users[username]
+	if !ok {
+		p = &userPresence{}
+		r.users[username] = p
+	}
+	old := p.presence()
+	f(p)
+	presence := p.presence()
+	if p.connections == 0 && p.customStatus == "" {
+		delete(r.users, username)
+	}
+
+	if presence != old && r.onChange != nil {
+		r.onChange(username, presence)
+	}
+	return presence
+}
+
+// validateCustomStatus checks that the custom status is a short valid UTF-8 string.
+func validateCustomStatus(customStatus string) error {
+	if !utf8.ValidString(customStatus) {
+		return fmt.Errorf("custom status is not a valid UTF-8 string")
+	}
+	if utf8.RuneCountInString(customStatus) > maxCustomStatusLength {
+		return fmt.Errorf("custom status is longer than %d characters", maxCustomStatusLength)
+	}
+	return nil
+}
+
+func getPBPresence(presence Presence) *pb.Presence {
+	return &pb.Presence{
+		Status:       AccordToPBPresenceStatuses[presence.Status],
+		CustomStatus: presence.CustomStatus,
+	}
+}
+
+func getPresence(m *pb.Presence) Presence {
+	return Presence{
+		Status:       PBToAccordPresenceStatuses[m.GetStatus()],
+		CustomStatus: m.GetCustomStatus(),
+	}
+}
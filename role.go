@@ -15,6 +15,15 @@ const (
 	SuperadminRole
 )
 
+// FirstCustomRole is the smallest value of roles defined by channels' superadmins.
+// All the roles below it are built-in.
+const FirstCustomRole Role = 100
+
+// IsCustom returns true if the role is defined by the channel rather than built-in.
+func (r Role) IsCustom() bool {
+	return r >= FirstCustomRole
+}
+
 var AccordToPBRoles = map[Role]pb.Role{
 	UnknownRole:    pb.Role_UNKNOWN_ROLE,
 	SubscriberRole: pb.Role_SUBSCRIBER,
@@ -30,3 +39,20 @@ var PBToAccordRoles = map[pb.Role]Role{
 	pb.Role_ADMIN:        AdminRole,
 	pb.Role_SUPERADMIN:   SuperadminRole,
 }
+
+// getPBRole turns the role into the built-in role from "pb" package and the
+// Id of custom role, only one of which is set.
+func getPBRole(role Role) (pb.Role, int32) {
+	if role.IsCustom() {
+		return pb.Role_UNKNOWN_ROLE, int32(role)
+	}
+	return AccordToPBRoles[role], 0
+}
+
+// getRoleFromPB is the inverse of getPBRole.
+func getRoleFromPB(role pb.Role, customRoleID int32) Role {
+	if customRoleID != 0 {
+		return Role(customRoleID)
+	}
+	return PBToAccordRoles[role]
+}
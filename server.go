@@ -2,23 +2,36 @@ package accord
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/qvntm/accord/pb"
 )
 
 const (
-	secretKey     = "secret"
-	tokenDuration = 15 * time.Minute
+	// defaultPageSize is the number of messages returned by GetMessages if
+	// the client hasn't specified the page size.
+	defaultPageSize = 50
+	// maxPageSize is the maximal number of messages returned by GetMessages.
+	maxPageSize = 500
+	// unreadPageSize is the number of messages, which are read from the
+	// storage at once to count unread messages in GetChannels.
+	unreadPageSize = 1000
+	// maxDirectChannelUsers is the maximal number of users in a direct channel,
+	// including the user, who opens it.
+	maxDirectChannelUsers = 10
 )
 
 type AccordServer struct {
@@ -27,41 +40,175 @@ type AccordServer struct {
 	listener        net.Listener
 	mutex           sync.RWMutex
 	channels        map[uint64]*ServerChannel
-	jwtManager      *JWTManager
+	// directChannels maps directKey of the members of each direct channel to its Id
+	directChannels map[string]uint64
+	// inviteCodes maps invite codes of all channels to Ids of their channels
+	inviteCodes map[string]uint64
+	// serverStreams are streams of clients subscribed to server-wide events
+	serverStreams *serverStreamRegistry
+	// presence tracks which users are connected through any of the streams
+	presence *presenceRegistry
+	// queues configures queues of channel streams and counts their overflows
+	queues  *streamQueues
+	storage Storage
+	config  *ServerConfig
+	// tlsCredentials are loaded on creation, so that missing files are
+	// reported before the server starts
+	tlsCredentials credentials.TransportCredentials
 }
 
+// NewAccordServer creates a new server with the default config, which keeps
+// all of its state in memory.
 func NewAccordServer() *AccordServer {
-	authServer := NewAuthServer()
-	return &AccordServer{
+	s, err := NewAccordServerWithConfig(DefaultServerConfig(), NewMemoryStorage())
+	if err != nil {
+		log.Fatalf("Cannot create server: %v", err)
+	}
+	return s
+}
+
+// NewAccordServerWithStorage creates a new server with the default config, which
+// persists its state in the given storage. The state that is already in the
+// storage is loaded on creation.
+func NewAccordServerWithStorage(storage Storage) (*AccordServer, error) {
+	return NewAccordServerWithConfig(DefaultServerConfig(), storage)
+}
+
+// NewAccordServerWithConfig creates a new server configured by the config, which
+// persists its state in the given storage. The state that is already in the
+// storage is loaded on creation.
+func NewAccordServerWithConfig(config *ServerConfig, storage Storage) (*AccordServer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	jwtManager, err := config.JWT.newJWTManager()
+	if err != nil {
+		return nil, err
+	}
+	tlsCredentials, err := loadServerTLSCredentials(&config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load TLS credentials: %w", err)
+	}
+
+	authServer := NewAuthServer(storage, jwtManager)
+	s := &AccordServer{
 		authServer:      authServer,
-		authInterceptor: NewServerAuthInterceptor(authServer.JWTManager()),
+		authInterceptor: NewServerAuthInterceptor(jwtManager, authServer.Denylist()),
 		channels:        make(map[uint64]*ServerChannel),
-		jwtManager:      NewJWTManager(secretKey, tokenDuration),
+		directChannels:  make(map[string]uint64),
+		inviteCodes:     make(map[string]uint64),
+		serverStreams:   newServerStreamRegistry(config.Streams.QueueSize),
+		queues:          newStreamQueues(config.Streams),
+		storage:         storage,
+		config:          config,
+		tlsCredentials:  tlsCredentials,
+	}
+	s.presence = newPresenceRegistry(s.broadcastPresence)
+	if config.TLS.ClientAuth != ClientAuthNone {
+		s.authInterceptor.EnableClientCertificates(s.certUsername)
 	}
+	if err := s.LoadUsers(); err != nil {
+		return nil, fmt.Errorf("cannot load users: %w", err)
+	}
+	if err := authServer.Denylist().Load(); err != nil {
+		return nil, fmt.Errorf("cannot load revoked tokens: %w", err)
+	}
+	if err := s.LoadChannels(); err != nil {
+		return nil, fmt.Errorf("cannot load channels: %w", err)
+	}
+	return s, nil
 }
 
-// LoadChannels loads channels from the persistent storage
+// LoadChannels loads channels and their members from the persistent storage.
+// Users have to be loaded before channels.
 func (s *AccordServer) LoadChannels() error {
-	return fmt.Errorf("unimplemented")
+	records, err := s.storage.Channels()
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.authServer.mutex.RLock()
+	defer s.authServer.mutex.RUnlock()
+
+	for _, record := range records {
+		ch := newServerChannelFromRecord(s.storage, record)
+		ch.serverStreams = s.serverStreams
+		ch.queues = s.queues
+		userRecords, err := s.storage.ChannelUsers(record.ChannelID)
+		if err != nil {
+			return err
+		}
+		for _, userRecord := range userRecords {
+			user := s.authServer.users[userRecord.Username]
+			if user == nil {
+				log.Printf("Skipping unknown user %s in channel %d", userRecord.Username, record.ChannelID)
+				continue
+			}
+			ch.users[userRecord.Username] = &channelUser{
+				user:       user,
+				role:       userRecord.Role,
+				lastReadID: userRecord.LastReadID,
+			}
+		}
+		banRecords, err := s.storage.Bans(record.ChannelID)
+		if err != nil {
+			return err
+		}
+		for _, ban := range banRecords {
+			ch.bans[ban.Username] = ban
+		}
+		requestRecords, err := s.storage.MembershipRequests(record.ChannelID)
+		if err != nil {
+			return err
+		}
+		for _, request := range requestRecords {
+			ch.pending[request.Username] = request
+		}
+		codeRecords, err := s.storage.InviteCodes(record.ChannelID)
+		if err != nil {
+			return err
+		}
+		for _, code := range codeRecords {
+			ch.inviteCodes[code.Code] = code
+			s.inviteCodes[code.Code] = ch.channelId
+		}
+
+		s.channels[ch.channelId] = ch
+		if ch.isDirect {
+			s.directChannels[directKey(ch.members())] = ch.channelId
+		}
+		go ch.listen()
+	}
+	return nil
 }
 
-// LoadUsers loads channels from the persistent storage
+// LoadUsers loads users from the persistent storage
 func (s *AccordServer) LoadUsers() error {
-	return fmt.Errorf("unimplemented")
+	return s.authServer.loadUsers()
 }
 
-func getUsernameFromContext(ctx context.Context) (string, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return "", fmt.Errorf("metadata is not provided")
+// certUsername returns the user named in the client certificate, who has to exist.
+func (s *AccordServer) certUsername(cert *x509.Certificate) (string, error) {
+	username, err := s.config.TLS.certUsername(cert)
+	if err != nil {
+		return "", err
 	}
-
-	values := md["username"]
-	if len(values) == 0 {
-		return "", fmt.Errorf("there is no username key in metadata")
+	if s.authServer.GetUser(username) == nil {
+		return "", fmt.Errorf("user %s doesn't exist", username)
 	}
+	return username, nil
+}
 
-	return values[0], nil
+// getUsernameFromContext returns the username of the user authenticated by
+// ServerAuthInterceptor.
+func getUsernameFromContext(ctx context.Context) (string, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("user is not authenticated")
+	}
+	return claims.Username, nil
 }
 
 // AddChannel creates a new channel with given parameters. The user who created the channel
@@ -72,21 +219,38 @@ func (s *AccordServer) AddChannel(ctx context.Context, req *pb.AddChannelRequest
 
 	username, err := getUsernameFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	if username == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "username cannot be empty")
 	}
 
-	ch := NewServerChannel(uint64(len(s.channels)), req.GetName(), req.GetIsPublic())
-	ch.users[username] = &channelUser{
-		user: s.authServer.users[username],
+	channelID, err := s.storage.NextChannelID()
+	if err != nil {
+		log.Printf("Failed to get Id for channel %s: %v", req.GetName(), err)
+		return nil, status.Errorf(codes.Internal, "cannot save the channel")
+	}
+	ch := NewServerChannel(s.storage, channelID, req.GetName(), req.GetIsPublic())
+	ch.serverStreams = s.serverStreams
+	ch.queues = s.queues
+	if err := s.storage.SaveChannel(ch.record()); err != nil {
+		log.Printf("Failed to save channel %s: %v", req.GetName(), err)
+		return nil, status.Errorf(codes.Internal, "cannot save the channel")
+	}
+	superadmin := &channelUser{
+		user: s.authServer.GetUser(username),
 		role: SuperadminRole,
 	}
+	if superadmin.user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %s doesn't exist", username)
+	}
+	if err := ch.addUser(superadmin); err != nil {
+		log.Print(err)
+		return nil, status.Errorf(codes.Internal, "cannot save the channel")
+	}
 
-	// TODO: add the new channel to the DB.
-	// TODO: broadcast to ServerStream creation of new channel.
 	s.channels[ch.channelId] = ch
+	s.serverStreams.broadcastAddChannel(ch)
 	go ch.listen()
 
 	res := &pb.AddChannelResponse{
@@ -96,14 +260,35 @@ func (s *AccordServer) AddChannel(ctx context.Context, req *pb.AddChannelRequest
 	return res, nil
 }
 
-func (s *AccordServer) RemoveChannel(_ context.Context, req *pb.RemoveChannelRequest) (*pb.RemoveChannelResponse, error) {
+func (s *AccordServer) RemoveChannel(ctx context.Context, req *pb.RemoveChannelRequest) (*pb.RemoveChannelResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	channelId := req.GetChannelId()
-	if _, ok := s.channels[req.GetChannelId()]; ok {
-		// TODO: remove the record from the DB.
-		// TODO: broadcast to ServerStream removal of the channel.
+	if ch, ok := s.channels[req.GetChannelId()]; ok {
+		if err := ch.do(func() error {
+			return ch.authorize(username, RemoveChannelPermission)
+		}); err != nil {
+			return nil, err
+		}
+		if err := s.storage.RemoveChannel(channelId); err != nil {
+			log.Printf("Failed to remove channel %d: %v", channelId, err)
+			return nil, status.Errorf(codes.Internal, "cannot remove the channel")
+		}
 		delete(s.channels, req.GetChannelId())
+		// once the channel is stopped, its state can be accessed directly
+		ch.remove(username)
+		if ch.isDirect {
+			delete(s.directChannels, directKey(ch.members()))
+		}
+		for code := range ch.inviteCodes {
+			delete(s.inviteCodes, code)
+		}
+		s.serverStreams.broadcastRemoveChannel(ch)
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", channelId)
 	}
@@ -114,13 +299,52 @@ func (s *AccordServer) RemoveChannel(_ context.Context, req *pb.RemoveChannelReq
 }
 
 func (s *AccordServer) GetChannels(ctx context.Context, req *pb.GetChannelsRequest) (*pb.GetChannelsResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	channel_metas := make(map[uint64]*pb.GetChannelsResponse_ChannelMeta)
 
-	for k := range s.channels {
-		meta := &pb.GetChannelsResponse_ChannelMeta{
-			Name:         s.channels[k].name,
-			IsPublic:     s.channels[k].isPublic,
-			MembersCount: int32(len(s.channels[k].users)),
+	// the storage is read without holding the lock, so that creation and
+	// removal of channels don't wait for counting of unread messages
+	s.mutex.RLock()
+	channels := make(map[uint64]*ServerChannel, len(s.channels))
+	for k, channel := range s.channels {
+		channels[k] = channel
+	}
+	s.mutex.RUnlock()
+	for k, channel := range channels {
+		var meta *pb.GetChannelsResponse_ChannelMeta
+		var isMember bool
+		if err := channel.do(func() error {
+			user, ok := channel.users[username]
+			if channel.isDirect && !ok {
+				return nil
+			}
+			isMember = ok
+			meta = &pb.GetChannelsResponse_ChannelMeta{
+				Name:         channel.name,
+				IsPublic:     channel.isPublic,
+				MembersCount: int32(len(channel.users)),
+				IsDirect:     channel.isDirect,
+			}
+			if isMember {
+				meta.LastReadId = user.lastReadID
+			}
+			return nil
+		}); err != nil {
+			// the channel has been removed since the list was copied
+			continue
+		}
+		if meta == nil {
+			continue
+		}
+		if isMember {
+			if meta.UnreadCount, meta.MentionCount, err = s.unreadCounts(k, username, meta.LastReadId); err != nil {
+				log.Printf("Failed to count unread messages of channel %d: %v", k, err)
+				return nil, status.Errorf(codes.Internal, "cannot count unread messages")
+			}
 		}
 		channel_metas[k] = meta
 	}
@@ -132,26 +356,84 @@ func (s *AccordServer) GetChannels(ctx context.Context, req *pb.GetChannelsReque
 	return res, nil
 }
 
+// unreadCounts returns the number of messages of others in the channel after
+// the last message read by the user, and the number of those mentioning the user.
+func (s *AccordServer) unreadCounts(channelID uint64, username string, lastReadID uint64) (int32, int32, error) {
+	var unread, mentioned int32
+	afterID := lastReadID
+	for {
+		records, err := s.storage.Messages(channelID, afterID, 0, unreadPageSize, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, msg := range records {
+			if msg.Sender == username {
+				continue
+			}
+			unread++
+			if mentions(msg.Content, username) {
+				mentioned++
+			}
+		}
+		if len(records) < unreadPageSize {
+			return unread, mentioned, nil
+		}
+		afterID = records[len(records)-1].MessageID
+	}
+}
+
 func (s *AccordServer) GetChannel(ctx context.Context, req *pb.GetChannelRequest) (*pb.GetChannelResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
 	channel, ok := s.channels[req.GetChannelId()]
+	s.mutex.RUnlock()
 	if !ok {
 		return nil, status.Errorf(codes.InvalidArgument, "Channel with Id %d doesn't exist", req.GetChannelId())
 	}
 
 	users := make(map[string]*pb.GetChannelResponse_User)
-
-	for uname, user := range channel.users {
-		users[uname] = &pb.GetChannelResponse_User{
-			Username: uname,
-			Role:     int32(user.role),
+	var info *pb.GetChannelResponse_ChannelInfo
+	if err := channel.do(func() error {
+		if err := channel.authorize(username, ReadPermission); err != nil {
+			return err
+		}
+		for uname, user := range channel.users {
+			users[uname] = &pb.GetChannelResponse_User{
+				Username: uname,
+				Role:     int32(user.role),
+			}
+		}
+		info = &pb.GetChannelResponse_ChannelInfo{
+			ChannelId:   channel.channelId,
+			Name:        channel.name,
+			Users:       users,
+			PinnedMsgId: channel.pinnedMsgId,
+			IsPublic:    channel.isPublic,
+			Roles:       channel.roleDefinitions(),
+			IsDirect:    channel.isDirect,
+		}
+		var err error
+		if channel.hasPermission(username, KickPermission) || channel.hasPermission(username, BanPermission) {
+			if info.Bans, err = channel.activeBans(); err != nil {
+				return status.Errorf(codes.Internal, "cannot convert bans: %v", err)
+			}
 		}
+		if channel.hasPermission(username, AssignRolePermission) {
+			if info.Invites, info.JoinRequests, err = channel.pendingMembers(); err != nil {
+				return status.Errorf(codes.Internal, "cannot convert membership requests: %v", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	info := &pb.GetChannelResponse_ChannelInfo{
-		ChannelId:   channel.channelId,
-		Name:        channel.name,
-		Users:       users,
-		PinnedMsgId: channel.pinnedMsgId,
-		IsPublic:    channel.isPublic,
+	// presence is got outside of the channel, since presence changes wait for it
+	for uname, user := range users {
+		user.Presence = getPBPresence(s.presence.get(uname))
 	}
 
 	res := &pb.GetChannelResponse{
@@ -160,10 +442,728 @@ func (s *AccordServer) GetChannel(ctx context.Context, req *pb.GetChannelRequest
 	return res, nil
 }
 
+// OpenDirectChannel returns the direct channel of the caller and the users. The
+// channel is created with all of them as members if it doesn't exist yet.
+func (s *AccordServer) OpenDirectChannel(ctx context.Context, req *pb.OpenDirectChannelRequest) (*pb.OpenDirectChannelResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	seen := map[string]bool{username: true}
+	usernames := []string{username}
+	for _, other := range req.GetUsernames() {
+		if !seen[other] {
+			seen[other] = true
+			usernames = append(usernames, other)
+		}
+	}
+	if len(usernames) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "direct channel has to have other users than the caller")
+	}
+	if len(usernames) > maxDirectChannelUsers {
+		return nil, status.Errorf(codes.InvalidArgument, "direct channel cannot have more than %d users", maxDirectChannelUsers)
+	}
+	sort.Strings(usernames)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := directKey(usernames)
+	if channelID, ok := s.directChannels[key]; ok {
+		return &pb.OpenDirectChannelResponse{ChannelId: channelID}, nil
+	}
+
+	members := make([]*channelUser, len(usernames))
+	for i, name := range usernames {
+		members[i] = &channelUser{
+			user: s.authServer.GetUser(name),
+			role: MemberRole,
+		}
+		if members[i].user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %s doesn't exist", name)
+		}
+	}
+
+	channelID, err := s.storage.NextChannelID()
+	if err != nil {
+		log.Printf("Failed to get Id for direct channel: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot save the channel")
+	}
+	ch := NewServerChannel(s.storage, channelID, strings.Join(usernames, ", "), false)
+	ch.isDirect = true
+	ch.serverStreams = s.serverStreams
+	ch.queues = s.queues
+	if err := s.storage.SaveChannel(ch.record()); err != nil {
+		log.Printf("Failed to save direct channel %s: %v", ch.name, err)
+		return nil, status.Errorf(codes.Internal, "cannot save the channel")
+	}
+	for _, member := range members {
+		if err := ch.addUser(member); err != nil {
+			log.Print(err)
+			return nil, status.Errorf(codes.Internal, "cannot save the channel")
+		}
+	}
+
+	s.channels[ch.channelId] = ch
+	s.directChannels[key] = ch.channelId
+	s.serverStreams.broadcastAddChannel(ch)
+	go ch.listen()
+
+	log.Printf("New direct channel %s created", ch.name)
+	return &pb.OpenDirectChannelResponse{ChannelId: ch.channelId}, nil
+}
+
+// GetMessages returns one page of the channel's message history.
+func (s *AccordServer) GetMessages(ctx context.Context, req *pb.GetMessagesRequest) (*pb.GetMessagesResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	channel, ok := s.channels[req.GetChannelId()]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := channel.do(func() error {
+		return channel.authorize(username, ReadPermission)
+	}); err != nil {
+		return nil, err
+	}
+
+	// pages go forward only if the client has asked for messages after some message
+	newest := req.GetAfterId() == 0 || req.GetBeforeId() != 0
+	records, err := s.storage.Messages(channel.channelId, req.GetAfterId(), req.GetBeforeId(), getPageSize(req.GetPageSize()), newest)
+	if err != nil {
+		log.Printf("Failed to get messages of channel %d: %v", channel.channelId, err)
+		return nil, status.Errorf(codes.Internal, "cannot get messages")
+	}
+	messages, err := getPBMessages(records)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeSeenBy() {
+		if err := channel.do(func() error {
+			// subscribers of public channels can read messages, but not
+			// what members have read
+			if _, ok := channel.users[username]; !ok {
+				return status.Errorf(codes.PermissionDenied, "only members of channel %d can see who has read its messages", channel.channelId)
+			}
+			for i, msg := range records {
+				messages[i].SeenBy = channel.seenBy(msg)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &pb.GetMessagesResponse{
+		Messages: messages,
+	}
+	return res, nil
+}
+
+// GetThread returns one page of replies in the thread.
+func (s *AccordServer) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.GetThreadResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	channel, ok := s.channels[req.GetChannelId()]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := channel.do(func() error {
+		return channel.authorize(username, ReadPermission)
+	}); err != nil {
+		return nil, err
+	}
+
+	root, err := s.storage.Message(channel.channelId, req.GetThreadRootId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "message %d doesn't exist in channel %d", req.GetThreadRootId(), channel.channelId)
+	}
+	if root.ThreadRootID != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "message %d is a reply in thread %d", root.MessageID, root.ThreadRootID)
+	}
+
+	newest := req.GetAfterId() == 0 || req.GetBeforeId() != 0
+	records, err := s.storage.ThreadMessages(channel.channelId, root.MessageID, req.GetAfterId(), req.GetBeforeId(), getPageSize(req.GetPageSize()), newest)
+	if err != nil {
+		log.Printf("Failed to get thread %d of channel %d: %v", root.MessageID, channel.channelId, err)
+		return nil, status.Errorf(codes.Internal, "cannot get thread")
+	}
+	replies, err := getPBMessages(records)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetThreadResponse{
+		Replies: replies,
+	}
+	if !root.Deleted {
+		if res.Root, err = getPBMessage(root); err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot convert message %d: %v", root.MessageID, err)
+		}
+	}
+	return res, nil
+}
+
+// getPageSize returns the number of messages in the page requested by the client.
+func getPageSize(requested int32) int {
+	pageSize := int(requested)
+	if pageSize <= 0 {
+		return defaultPageSize
+	} else if pageSize > maxPageSize {
+		return maxPageSize
+	}
+	return pageSize
+}
+
+func getPBMessages(records []*MessageRecord) ([]*pb.Message, error) {
+	messages := make([]*pb.Message, 0, len(records))
+	for _, record := range records {
+		msg, err := getPBMessage(record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot convert message %d: %v", record.MessageID, err)
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// SetPresence changes presence of the caller on all of the caller's connections.
+// The change is broadcasted to members of the caller's channels.
+func (s *AccordServer) SetPresence(ctx context.Context, req *pb.SetPresenceRequest) (*pb.SetPresenceResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var idle bool
+	switch req.GetStatus() {
+	case pb.PresenceStatus_ONLINE:
+	case pb.PresenceStatus_IDLE:
+		idle = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "presence status %v cannot be set", req.GetStatus())
+	}
+	if err := validateCustomStatus(req.GetCustomStatus()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	presence := s.presence.set(username, idle, req.GetCustomStatus())
+	return &pb.SetPresenceResponse{
+		Presence: getPBPresence(presence),
+	}, nil
+}
+
+// InviteUser invites the user to join the channel with the role. Only users,
+// who can assign roles in the channel, can invite others.
+func (s *AccordServer) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.InviteUserResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := ch.do(func() error {
+		if ch.isDirect {
+			return status.Errorf(codes.FailedPrecondition, "direct channel %d cannot be joined", ch.channelId)
+		}
+		if err := ch.authorize(username, AssignRolePermission); err != nil {
+			return err
+		}
+		role := getMembershipRole(req.GetRole(), req.GetCustomRoleId())
+		if !ch.roleExists(role) {
+			return status.Errorf(codes.InvalidArgument, "role %d doesn't exist in channel %d", role, ch.channelId)
+		}
+		if role == SuperadminRole && ch.roleOf(username) != SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can invite superadmins of channel %d", ch.channelId)
+		}
+		if err := ch.authorizeGrant(username, role); err != nil {
+			return err
+		}
+		invitee := req.GetUsername()
+		if s.authServer.GetUser(invitee) == nil {
+			return status.Errorf(codes.NotFound, "user %s doesn't exist", invitee)
+		}
+		if _, ok := ch.users[invitee]; ok {
+			return status.Errorf(codes.AlreadyExists, "user %s is already in channel %d", invitee, ch.channelId)
+		}
+		if ch.isBanned(invitee) {
+			return status.Errorf(codes.FailedPrecondition, "user %s is banned in channel %d", invitee, ch.channelId)
+		}
+
+		record := &MembershipRequestRecord{
+			Username:  invitee,
+			Role:      role,
+			InvitedBy: username,
+			CreatedAt: time.Now(),
+		}
+		if err := ch.saveMembershipRequest(record); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot save the invite")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.InviteUserResponse{}, nil
+}
+
+// GetInvites returns pending invites of the caller to all channels.
+func (s *AccordServer) GetInvites(ctx context.Context, req *pb.GetInvitesRequest) (*pb.GetInvitesResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	res := &pb.GetInvitesResponse{}
+	for _, ch := range s.channels {
+		ch := ch
+		if err := ch.do(func() error {
+			record := ch.invite(username)
+			if record == nil {
+				return nil
+			}
+			invite, err := getPBInvite(ch, record)
+			if err != nil {
+				return status.Errorf(codes.Internal, "cannot convert invite: %v", err)
+			}
+			res.Invites = append(res.Invites, invite)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(res.Invites, func(i, j int) bool {
+		return res.Invites[i].ChannelId < res.Invites[j].ChannelId
+	})
+	return res, nil
+}
+
+// RespondToInvite makes the caller a member of the channel, which the caller
+// has been invited to, or declines the invite.
+func (s *AccordServer) RespondToInvite(ctx context.Context, req *pb.RespondToInviteRequest) (*pb.RespondToInviteResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := ch.do(func() error {
+		invite := ch.invite(username)
+		if invite == nil {
+			return status.Errorf(codes.NotFound, "user %s hasn't been invited to channel %d", username, ch.channelId)
+		}
+		if !req.GetAccept() {
+			if err := ch.removeMembershipRequest(username); err != nil {
+				log.Print(err)
+				return status.Errorf(codes.Internal, "cannot decline the invite")
+			}
+			return nil
+		}
+
+		user := s.authServer.GetUser(username)
+		if user == nil {
+			return status.Errorf(codes.NotFound, "user %s doesn't exist", username)
+		}
+		if err := ch.join(user, invite.Role); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot join the channel")
+		}
+		log.Printf("User %s has joined channel %d invited by %s", username, ch.channelId, invite.InvitedBy)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.RespondToInviteResponse{}, nil
+}
+
+// RequestToJoin requests the caller's membership in the public channel, which
+// has to be approved by a user, who can assign roles in the channel.
+func (s *AccordServer) RequestToJoin(ctx context.Context, req *pb.RequestToJoinRequest) (*pb.RequestToJoinResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := ch.do(func() error {
+		if !ch.isPublic {
+			return status.Errorf(codes.FailedPrecondition, "private channel %d can only be joined by invite", ch.channelId)
+		}
+		if ch.isBanned(username) {
+			return status.Errorf(codes.PermissionDenied, "user %s is banned in channel %d", username, ch.channelId)
+		}
+		if _, ok := ch.users[username]; ok {
+			return status.Errorf(codes.AlreadyExists, "user %s is already in channel %d", username, ch.channelId)
+		}
+		if ch.invite(username) != nil {
+			return status.Errorf(codes.FailedPrecondition, "user %s has been invited to channel %d", username, ch.channelId)
+		}
+		role := getMembershipRole(req.GetRole(), req.GetCustomRoleId())
+		if !ch.roleExists(role) {
+			return status.Errorf(codes.InvalidArgument, "role %d doesn't exist in channel %d", role, ch.channelId)
+		}
+
+		record := &MembershipRequestRecord{
+			Username:  username,
+			Role:      role,
+			CreatedAt: time.Now(),
+		}
+		if err := ch.saveMembershipRequest(record); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot save the request")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.RequestToJoinResponse{}, nil
+}
+
+// ReviewJoinRequest approves or rejects the request of the user to join the
+// channel. Only users, who can assign roles in the channel, can review requests.
+func (s *AccordServer) ReviewJoinRequest(ctx context.Context, req *pb.ReviewJoinRequestRequest) (*pb.ReviewJoinRequestResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := ch.do(func() error {
+		if err := ch.authorize(username, AssignRolePermission); err != nil {
+			return err
+		}
+		joinRequest := ch.joinRequest(req.GetUsername())
+		if joinRequest == nil {
+			return status.Errorf(codes.NotFound, "user %s hasn't requested to join channel %d", req.GetUsername(), ch.channelId)
+		}
+		if !req.GetApprove() {
+			if err := ch.removeMembershipRequest(joinRequest.Username); err != nil {
+				log.Print(err)
+				return status.Errorf(codes.Internal, "cannot reject the request")
+			}
+			return nil
+		}
+		if joinRequest.Role == SuperadminRole && ch.roleOf(username) != SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can make superadmins of channel %d", ch.channelId)
+		}
+		if err := ch.authorizeGrant(username, joinRequest.Role); err != nil {
+			return err
+		}
+
+		user := s.authServer.GetUser(joinRequest.Username)
+		if user == nil {
+			return status.Errorf(codes.NotFound, "user %s doesn't exist", joinRequest.Username)
+		}
+		if err := ch.join(user, joinRequest.Role); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot join the channel")
+		}
+		log.Printf("User %s has joined channel %d approved by %s", joinRequest.Username, ch.channelId, username)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.ReviewJoinRequestResponse{}, nil
+}
+
+// CreateInviteCode creates a new code, which lets users join the channel with
+// the role. Only users, who can assign roles in the channel, can create codes.
+func (s *AccordServer) CreateInviteCode(ctx context.Context, req *pb.CreateInviteCodeRequest) (*pb.CreateInviteCodeResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	var record *InviteCodeRecord
+	if err := ch.do(func() error {
+		if ch.isDirect {
+			return status.Errorf(codes.FailedPrecondition, "direct channel %d cannot be joined", ch.channelId)
+		}
+		if err := ch.authorize(username, AssignRolePermission); err != nil {
+			return err
+		}
+		role := getMembershipRole(req.GetRole(), req.GetCustomRoleId())
+		if !ch.roleExists(role) {
+			return status.Errorf(codes.InvalidArgument, "role %d doesn't exist in channel %d", role, ch.channelId)
+		}
+		if role == SuperadminRole && ch.roleOf(username) != SuperadminRole {
+			return status.Errorf(codes.PermissionDenied, "only superadmins can invite superadmins of channel %d", ch.channelId)
+		}
+		if err := ch.authorizeGrant(username, role); err != nil {
+			return err
+		}
+		if expiresAt := req.GetExpiresAt(); expiresAt != nil {
+			if err := expiresAt.CheckValid(); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid expiration time: %v", err)
+			}
+			if !expiresAt.AsTime().After(time.Now()) {
+				return status.Errorf(codes.InvalidArgument, "expiration time has to be in the future")
+			}
+		}
+
+		code, err := newInviteCode()
+		if err != nil {
+			log.Printf("Failed to generate invite code: %v", err)
+			return status.Errorf(codes.Internal, "cannot generate invite code")
+		}
+		record = &InviteCodeRecord{
+			Code:      code,
+			Role:      role,
+			CreatedBy: username,
+			CreatedAt: time.Now(),
+			ExpiresAt: getTime(req.GetExpiresAt()),
+			MaxUses:   req.GetMaxUses(),
+		}
+		if err := ch.saveInviteCode(record); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot save invite code")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	s.inviteCodes[record.Code] = ch.channelId
+
+	inviteCode, err := getPBInviteCode(ch.channelId, record)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot convert invite code: %v", err)
+	}
+	log.Printf("Invite code for channel %d created by %s", ch.channelId, username)
+	return &pb.CreateInviteCodeResponse{InviteCode: inviteCode}, nil
+}
+
+// GetInviteCodes returns all invite codes of the channel to users, who can
+// assign roles in the channel.
+func (s *AccordServer) GetInviteCodes(ctx context.Context, req *pb.GetInviteCodesRequest) (*pb.GetInviteCodesResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	var inviteCodes []*pb.InviteCode
+	if err := ch.do(func() error {
+		if err := ch.authorize(username, AssignRolePermission); err != nil {
+			return err
+		}
+		if inviteCodes, err = ch.sortedInviteCodes(); err != nil {
+			return status.Errorf(codes.Internal, "cannot convert invite codes: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.GetInviteCodesResponse{InviteCodes: inviteCodes}, nil
+}
+
+// RevokeInviteCode removes the invite code, so that it can no longer be used.
+func (s *AccordServer) RevokeInviteCode(ctx context.Context, req *pb.RevokeInviteCodeRequest) (*pb.RevokeInviteCodeResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ch, ok := s.channels[req.GetChannelId()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "channel with Id %d doesn't exist", req.GetChannelId())
+	}
+	if err := ch.do(func() error {
+		if err := ch.authorize(username, AssignRolePermission); err != nil {
+			return err
+		}
+		if _, ok := ch.inviteCodes[req.GetCode()]; !ok {
+			return status.Errorf(codes.NotFound, "invite code %s doesn't exist in channel %d", req.GetCode(), ch.channelId)
+		}
+		if err := ch.removeInviteCode(req.GetCode()); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot revoke invite code")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	delete(s.inviteCodes, req.GetCode())
+
+	log.Printf("Invite code %s of channel %d revoked by %s", req.GetCode(), ch.channelId, username)
+	return &pb.RevokeInviteCodeResponse{}, nil
+}
+
+// RedeemInviteCode makes the caller a member of the channel of the invite code.
+// The code is checked and its use is counted atomically, so it is never used
+// more times than it is allowed.
+func (s *AccordServer) RedeemInviteCode(ctx context.Context, req *pb.RedeemInviteCodeRequest) (*pb.RedeemInviteCodeResponse, error) {
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	channelID, ok := s.inviteCodes[req.GetCode()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "invite code %s doesn't exist", req.GetCode())
+	}
+	ch := s.channels[channelID]
+	if err := ch.do(func() error {
+		record := ch.inviteCodes[req.GetCode()]
+		if err := record.usable(); err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if ch.isBanned(username) {
+			return status.Errorf(codes.PermissionDenied, "user %s is banned in channel %d", username, ch.channelId)
+		}
+		if _, ok := ch.users[username]; ok {
+			return status.Errorf(codes.AlreadyExists, "user %s is already in channel %d", username, ch.channelId)
+		}
+		user := s.authServer.GetUser(username)
+		if user == nil {
+			return status.Errorf(codes.NotFound, "user %s doesn't exist", username)
+		}
+
+		// the use is counted first, so that the code cannot be used more times
+		// than allowed even if the server fails in between
+		redemption := InviteCodeRedemptionRecord{
+			Username:   username,
+			RedeemedAt: time.Now(),
+		}
+		used := *record
+		used.Uses++
+		// redemptions are copied, so that the record in use isn't changed
+		used.Redemptions = append(append([]InviteCodeRedemptionRecord(nil), record.Redemptions...), redemption)
+		if err := ch.saveInviteCode(&used); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot redeem invite code")
+		}
+		if err := ch.join(user, record.Role); err != nil {
+			log.Print(err)
+			return status.Errorf(codes.Internal, "cannot join the channel")
+		}
+		if err := ch.broadcastRedemption(record.Code, &redemption); err != nil {
+			log.Printf("Cannot broadcast redemption of invite code of channel %d: %v", ch.channelId, err)
+		}
+		log.Printf("User %s has joined channel %d with invite code %s (%d uses)", username, ch.channelId, record.Code, used.Uses)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.RedeemInviteCodeResponse{ChannelId: ch.channelId}, nil
+}
+
+// broadcastPresence sends the presence of the user to other users streaming
+// with the channels, which the user is in.
+func (s *AccordServer) broadcastPresence(username string, presence Presence) {
+	res := &pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_EphemeralMsg{
+			EphemeralMsg: &pb.EphemeralMessage{
+				Username: username,
+				Msg: &pb.EphemeralMessage_PresenceMsg{
+					PresenceMsg: &pb.EphemeralMessage_PresenceEphemeralMessage{
+						Presence: getPBPresence(presence),
+					},
+				},
+			},
+		},
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	for _, channel := range s.channels {
+		channel := channel
+		// the error is only returned for removed channels, which don't need to be told
+		_ = channel.do(func() error {
+			if _, ok := channel.users[username]; ok {
+				channel.broadcastExcept(res, username)
+			}
+			return nil
+		})
+	}
+}
+
+// ServerStream streams server-wide events, such as addition, removal and renaming
+// of channels, to the client until the client cancels the stream.
+func (s *AccordServer) ServerStream(req *pb.ServerStreamRequest, srv pb.Chat_ServerStreamServer) error {
+	ctx := srv.Context()
+	username, err := getUsernameFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to get username from context")
+	}
+
+	stream, err := s.serverStreams.add(username, srv)
+	if err != nil {
+		return err
+	}
+	defer s.serverStreams.remove(stream)
+	s.presence.connect(username)
+	defer s.presence.disconnect(username)
+
+	select {
+	case <-ctx.Done():
+		return status.Error(codes.Canceled, ctx.Err().Error())
+	case <-stream.closedc:
+		return errSlowServerConsumer
+	}
+}
+
 // ChannelStream is the implementation of bidirectional streaming of client
 // with one channel on the server.
 func (s *AccordServer) ChannelStream(srv pb.Chat_ChannelStreamServer) error {
 	var channel *ServerChannel = nil
+	var stream *channelStream = nil
 	ctx := srv.Context()
 
 	username, err := getUsernameFromContext(ctx)
@@ -173,42 +1173,138 @@ func (s *AccordServer) ChannelStream(srv pb.Chat_ChannelStreamServer) error {
 	if username == "" {
 		return status.Errorf(codes.InvalidArgument, "username cannot be empty")
 	}
+	s.presence.connect(username)
+	defer s.presence.disconnect(username)
 
+	// requests are received in a separate goroutine, so that the stream
+	// can also be closed by the channel, e.g. when the user is kicked
+	reqc, recvErrc, done := make(chan *pb.ChannelStreamRequest), make(chan error, 1), make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			req, err := srv.Recv()
+			if err != nil {
+				recvErrc <- err
+				return
+			}
+			select {
+			case reqc <- req:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// slow is set if the stream is closed, since the client cannot keep up
+	slow := false
+	defer func() {
+		if stream == nil {
+			return
+		}
+		channel.removeStream(username, stream)
+		// responses queued before the stream is closed by the server, e.g.
+		// the one about the user being kicked, are still sent
+		stream.stop(!slow)
+		if slow {
+			srv.SetTrailer(stream.resumeAfterTrailer())
+		}
+	}()
+
+	// closec is nil, i.e. never ready, until the stream is registered in the channel
+	var closec chan error
+	// removed is nil until the channel is known, and then it is closed when
+	// the channel is removed
+	var removed <-chan struct{}
 	for {
-		req, err := srv.Recv()
-		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
+		var req *pb.ChannelStreamRequest
+		select {
+		case req = <-reqc:
+		case err := <-recvErrc:
+			if err == io.EOF {
+				return nil
+			}
+			log.Printf("Error while reading client stream: %v", err)
+			return err
+		case err := <-closec:
+			slow = err == errSlowConsumer
+			return err
+		case <-removed:
+			return channel.removedError()
+		// handle abrupt client disconnection
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, ctx.Err().Error())
 		}
 
 		if channel == nil {
+			s.mutex.RLock()
 			channel = s.channels[req.GetChannelId()]
+			s.mutex.RUnlock()
 			if channel == nil {
-				return status.Errorf(codes.InvalidArgument, "invalid channel Id: %v", err)
+				return status.Errorf(codes.InvalidArgument, "invalid channel Id: %d", req.GetChannelId())
 			}
-			// so far, authomatically add user as a member when he subscribes to the channel
-			// TODO: add some RPC for user to request to join the channel with particular role.
-			if _, ok := channel.users[username]; !ok {
-				channel.users[username] = &channelUser{
-					user: s.authServer.users[username],
-					role: MemberRole,
+			removed = channel.removed
+			if err := channel.do(func() error {
+				if channel.isBanned(username) {
+					return status.Errorf(codes.PermissionDenied, "user %s is banned in channel %d", username, channel.channelId)
+				}
+				// streaming doesn't make the user a member, so users, who haven't
+				// joined, can only read public channels as their subscribers
+				if err := channel.authorize(username, ReadPermission); err != nil {
+					return err
+				}
+				// add the stream for broadcasting to the user, it is added by the
+				// channel, so that no message is added after the last one is read
+				// and before the stream receives broadcasts
+				lastMessageID, err := channel.lastMessageID()
+				if err != nil {
+					return status.Errorf(codes.Internal, "cannot get messages of channel %d: %v", channel.channelId, err)
 				}
+				stream = newChannelStream(srv, channel.queues.config.QueueSize, lastMessageID)
+				channel.addStream(username, stream)
+				return nil
+			}); err != nil {
+				return err
 			}
-			// add the stream for broadcasting to the user
-			channel.usersToStreams[username] = srv
+			closec = stream.closec
+			stream.start()
 		} else if reqChannelId := req.GetChannelId(); channel.channelId != reqChannelId {
 			return status.Errorf(codes.InvalidArgument, "each stream has to use consistent channel Ids\nhave:%d\nwant:%d\n", reqChannelId, channel.channelId)
 		}
+		// subscribing only registers the stream, so it doesn't need any
+		// permissions besides ReadPermission checked above
+		if req.GetSubscribeMsg() != nil {
+			continue
+		}
 
+		r := &channelStreamRequest{
+			username: username,
+			req:      req,
+			errc:     make(chan error, 1),
+		}
 		select {
-		// handle abrupt client disconnection
 		case <-ctx.Done():
-			channel.usersToStreams[username] = nil
 			return status.Error(codes.Canceled, ctx.Err().Error())
-		case channel.msgc <- req:
+		case err := <-closec:
+			slow = err == errSlowConsumer
+			return err
+		case <-removed:
+			return channel.removedError()
+		case channel.msgc <- r:
+		}
+		// rejected and failed requests are reported to the user, but only
+		// kicks and bans terminate the stream
+		if err := <-r.errc; err != nil {
+			if !stream.enqueue(getPBErrorResponse(err), channel.queues) {
+				slow = true
+				return errSlowConsumer
+			}
 		}
 	}
+}
 
-	return nil
+// StreamMetrics returns counters of overflows of channel streams' queues.
+func (s *AccordServer) StreamMetrics() StreamMetrics {
+	return s.queues.metrics()
 }
 
 func (s *AccordServer) Listen(serv_addr string) (string, error) {
@@ -224,16 +1320,12 @@ func (s *AccordServer) Listen(serv_addr string) (string, error) {
 }
 
 func (s *AccordServer) Start() {
-	tlsCredentials, err := loadTLSCredentials()
-	if err != nil {
-		log.Fatal("Cannot load TLS credentials:", err)
-	}
 	serverOptions := []grpc.ServerOption{
 		grpc.UnaryInterceptor(s.authInterceptor.Unary()),
 		grpc.StreamInterceptor(s.authInterceptor.Stream()),
 	}
 
-	serverOptions = append(serverOptions, grpc.Creds(tlsCredentials))
+	serverOptions = append(serverOptions, grpc.Creds(s.tlsCredentials))
 
 	srv := grpc.NewServer(serverOptions...)
 	pb.RegisterAuthServiceServer(srv, s.authServer)
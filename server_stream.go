@@ -0,0 +1,169 @@
+package accord
+
+import (
+	"log"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/qvntm/accord/pb"
+)
+
+// errSlowServerConsumer closes server streams, whose queues have overflown.
+var errSlowServerConsumer = status.Error(codes.ResourceExhausted, "client cannot keep up with server events")
+
+// serverStream is the stream of a client subscribed to the server-wide events.
+// Events are queued by the registry and sent by a separate goroutine, so that
+// slow clients don't hold up the server.
+type serverStream struct {
+	username string
+	stream   pb.Chat_ServerStreamServer
+	// queue holds events, which haven't been sent yet
+	queue chan *pb.ServerStreamResponse
+	// closedc is closed when the queue overflows, so the stream has to be closed
+	closedc chan struct{}
+}
+
+// sendQueued sends queued events until the stream is closed. It never holds
+// any lock while sending, and it is unblocked by the cancellation of the
+// stream's context once the handler of the stream returns.
+func (s *serverStream) sendQueued() {
+	ctx := s.stream.Context()
+	for {
+		select {
+		case res := <-s.queue:
+			if err := s.stream.Send(res); err != nil {
+				log.Printf("Could not send server event to %s: %v\n", s.username, err)
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// serverStreamRegistry keeps all the streams of clients subscribed to the
+// server-wide events.
+type serverStreamRegistry struct {
+	mutex sync.Mutex
+	// queueSize is the number of events, which can be queued for each stream
+	queueSize int
+	streams   map[*serverStream]bool
+}
+
+func newServerStreamRegistry(queueSize int) *serverStreamRegistry {
+	return &serverStreamRegistry{
+		queueSize: queueSize,
+		streams:   make(map[*serverStream]bool),
+	}
+}
+
+// add registers the stream and sends headers to let the client know that no
+// events will be missed from now on.
+func (r *serverStreamRegistry) add(username string, stream pb.Chat_ServerStreamServer) (*serverStream, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return nil, err
+	}
+	s := &serverStream{
+		username: username,
+		stream:   stream,
+		queue:    make(chan *pb.ServerStreamResponse, r.queueSize),
+		closedc:  make(chan struct{}),
+	}
+	r.streams[s] = true
+	go s.sendQueued()
+	return s, nil
+}
+
+func (r *serverStreamRegistry) remove(s *serverStream) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.streams, s)
+}
+
+// broadcast sends the event to all subscribed clients.
+func (r *serverStreamRegistry) broadcast(res *pb.ServerStreamResponse) {
+	r.broadcastToUsers(res, nil)
+}
+
+// broadcastToUsers queues the event for subscribed clients of the users, or
+// for all subscribed clients if usernames is nil. Clients, whose queues are
+// full, are disconnected regardless of the overflow policy, since they would
+// miss changes of the channels otherwise.
+func (r *serverStreamRegistry) broadcastToUsers(res *pb.ServerStreamResponse, usernames []string) {
+	var recipients map[string]bool
+	if usernames != nil {
+		recipients = make(map[string]bool, len(usernames))
+		for _, username := range usernames {
+			recipients[username] = true
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for s := range r.streams {
+		if recipients != nil && !recipients[s.username] {
+			continue
+		}
+		select {
+		case s.queue <- res:
+		default:
+			log.Printf("Disconnecting %s from server events, since the client cannot keep up\n", s.username)
+			delete(r.streams, s)
+			close(s.closedc)
+		}
+	}
+}
+
+// broadcastChannelAction sends the action to all subscribed clients, or only
+// to members of the channel if it is direct.
+func (r *serverStreamRegistry) broadcastChannelAction(ch *ServerChannel, action *pb.ServerStreamResponse_ChannelAction) {
+	var usernames []string
+	if ch.isDirect {
+		usernames = ch.members()
+	}
+	r.broadcastToUsers(&pb.ServerStreamResponse{
+		Event: &pb.ServerStreamResponse_ChannelAction_{
+			ChannelAction: action,
+		},
+	}, usernames)
+}
+
+func (r *serverStreamRegistry) broadcastAddChannel(ch *ServerChannel) {
+	r.broadcastChannelAction(ch, &pb.ServerStreamResponse_ChannelAction{
+		ChannelId: ch.channelId,
+		Action: &pb.ServerStreamResponse_ChannelAction_AddChannel_{
+			AddChannel: &pb.ServerStreamResponse_ChannelAction_AddChannel{
+				Name:     ch.name,
+				IsPublic: ch.isPublic,
+				IsDirect: ch.isDirect,
+			},
+		},
+	})
+}
+
+func (r *serverStreamRegistry) broadcastRemoveChannel(ch *ServerChannel) {
+	r.broadcastChannelAction(ch, &pb.ServerStreamResponse_ChannelAction{
+		ChannelId: ch.channelId,
+		Action: &pb.ServerStreamResponse_ChannelAction_RemoveChannel_{
+			RemoveChannel: &pb.ServerStreamResponse_ChannelAction_RemoveChannel{},
+		},
+	})
+}
+
+func (r *serverStreamRegistry) broadcastRenameChannel(ch *ServerChannel) {
+	r.broadcastChannelAction(ch, &pb.ServerStreamResponse_ChannelAction{
+		ChannelId: ch.channelId,
+		Action: &pb.ServerStreamResponse_ChannelAction_RenameChannel_{
+			RenameChannel: &pb.ServerStreamResponse_ChannelAction_RenameChannel{
+				NewName: ch.name,
+			},
+		},
+	})
+}
@@ -0,0 +1,242 @@
+package accord
+
+import "time"
+
+// UserRecord is the persistent representation of a User.
+type UserRecord struct {
+	Username       string
+	HashedPassword string
+}
+
+// ChannelRecord is the persistent representation of a ServerChannel. Members
+// of the channel are stored separately as ChannelUserRecords.
+type ChannelRecord struct {
+	ChannelID           uint64
+	Name                string
+	IsPublic            bool
+	PinnedMsgID         uint64
+	RolesWithPermission map[Permission][]Role
+	// CustomRoles maps custom roles of the channel to their names
+	CustomRoles map[Role]string
+	// IsDirect is set for direct channels, whose members are their participants
+	IsDirect bool
+}
+
+// ChannelUserRecord is the persistent representation of a user's membership
+// in a single channel.
+type ChannelUserRecord struct {
+	Username string
+	Role     Role
+	// LastReadID is the Id of the last message read by the user
+	LastReadID uint64
+}
+
+// MessageRecord is the persistent representation of a single message in the
+// channel's message log. Deleted messages stay in the log without content.
+type MessageRecord struct {
+	MessageID uint64
+	Timestamp time.Time
+	// Sender is the username of the author of the message
+	Sender  string
+	Content string
+	Deleted bool
+	// ReplyTo and ThreadRootID are set for replies, ThreadRootID is the first
+	// message of the thread, which has ReplyCount and LastReplyAt set.
+	ReplyTo      uint64
+	ThreadRootID uint64
+	ReplyCount   uint32
+	LastReplyAt  time.Time
+	// Reactions are sorted by the time the first user has reacted with the emoji
+	Reactions []ReactionRecord
+}
+
+// ReactionRecord contains users, who have reacted to the message with the
+// emoji, in the order they have reacted.
+type ReactionRecord struct {
+	Emoji     string
+	Usernames []string
+}
+
+// BanRecord is the persistent representation of a ban of the user in a
+// single channel. Zero ExpiresAt means that the ban is permanent.
+type BanRecord struct {
+	Username  string
+	Reason    string
+	BannedBy  string
+	BannedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// MembershipRequestRecord is the persistent representation of a pending invite
+// of the user to a single channel, or of the user's request to join it.
+type MembershipRequestRecord struct {
+	Username string
+	// Role is the role, which the user gets on joining the channel
+	Role Role
+	// InvitedBy is the username of the inviter, it is empty for requests to join
+	InvitedBy string
+	CreatedAt time.Time
+}
+
+// InviteCodeRecord is the persistent representation of a code, which lets
+// users join a single channel. Zero ExpiresAt means that the code never
+// expires, and zero MaxUses means that it can be used any number of times.
+type InviteCodeRecord struct {
+	Code      string
+	Role      Role
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	MaxUses   uint32
+	// Uses is the number of users, who have joined the channel with the code
+	Uses uint32
+	// Redemptions are the users, who have joined the channel with the code,
+	// in the order they have redeemed it.
+	Redemptions []InviteCodeRedemptionRecord
+}
+
+// InviteCodeRedemptionRecord is the persistent representation of the user
+// joining the channel with the invite code.
+type InviteCodeRedemptionRecord struct {
+	Username   string
+	RedeemedAt time.Time
+}
+
+// RevokedTokenRecord is the persistent representation of a token, which has
+// been revoked before it expires.
+type RevokedTokenRecord struct {
+	ID        string
+	ExpiresAt time.Time
+}
+
+// UserRevocationRecord is the persistent representation of revoking all the
+// sessions of the user. Tokens of the user issued before NotBefore are revoked.
+type UserRevocationRecord struct {
+	Username  string
+	NotBefore time.Time
+}
+
+// Storage is the persistent layer of the server. AuthServer, AccordServer
+// and ServerChannel write through it on every change of their state, and
+// AccordServer reads from it on startup to rehydrate that state.
+type Storage interface {
+	// SaveUser creates or overwrites the user with the same username.
+	SaveUser(user *UserRecord) error
+	// Users returns all the users known to the storage.
+	Users() ([]*UserRecord, error)
+
+	// NextChannelID returns the Id for a new channel. Ids are never returned
+	// twice, even if their channels have been removed, so that clients, which
+	// still know about removed channels, don't mistake new ones for them.
+	NextChannelID() (uint64, error)
+	// SaveChannel creates or overwrites the channel with the same Id.
+	SaveChannel(channel *ChannelRecord) error
+	// RemoveChannel permanently removes the channel and all of its members.
+	RemoveChannel(channelID uint64) error
+	// Channels returns all the channels known to the storage.
+	Channels() ([]*ChannelRecord, error)
+
+	// SaveChannelUser creates or overwrites the membership of the user in the channel.
+	SaveChannelUser(channelID uint64, user *ChannelUserRecord) error
+	// RemoveChannelUser removes the user from the channel.
+	RemoveChannelUser(channelID uint64, username string) error
+	// ChannelUsers returns all members of the channel.
+	ChannelUsers(channelID uint64) ([]*ChannelUserRecord, error)
+
+	// AppendMessage appends the message to the end of the channel's message log.
+	// The storage assigns the message a new Id, which is greater than Ids of all
+	// messages previously appended to the channel, and returns it.
+	AppendMessage(channelID uint64, msg *MessageRecord) (uint64, error)
+	// UpdateMessage overwrites the message with the same Id in the channel's log.
+	UpdateMessage(channelID uint64, msg *MessageRecord) error
+	// Message returns the message with the given Id from the channel's log.
+	Message(channelID uint64, messageID uint64) (*MessageRecord, error)
+	// Messages returns at most limit messages from the channel's log, which are
+	// not deleted and have Ids between afterID and beforeID (both exclusive).
+	// Zero beforeID means that there is no upper bound. If newest is set, the
+	// newest messages of the range are returned, otherwise the oldest ones.
+	// Messages are always sorted by their Ids in increasing order.
+	Messages(channelID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error)
+	// ThreadMessages returns replies in the thread started by the message with
+	// Id threadRootID the same way as Messages returns messages of the channel.
+	ThreadMessages(channelID uint64, threadRootID uint64, afterID uint64, beforeID uint64, limit int, newest bool) ([]*MessageRecord, error)
+
+	// SaveBan creates or overwrites the ban of the user in the channel.
+	SaveBan(channelID uint64, ban *BanRecord) error
+	// RemoveBan lifts the ban of the user in the channel.
+	RemoveBan(channelID uint64, username string) error
+	// Bans returns all bans of the channel, including expired ones.
+	Bans(channelID uint64) ([]*BanRecord, error)
+
+	// SaveMembershipRequest creates or overwrites the pending invite or
+	// request to join of the user in the channel.
+	SaveMembershipRequest(channelID uint64, request *MembershipRequestRecord) error
+	// RemoveMembershipRequest removes the pending invite or request to join
+	// of the user in the channel.
+	RemoveMembershipRequest(channelID uint64, username string) error
+	// MembershipRequests returns all pending invites and requests to join the channel.
+	MembershipRequests(channelID uint64) ([]*MembershipRequestRecord, error)
+
+	// SaveInviteCode creates or overwrites the invite code of the channel.
+	SaveInviteCode(channelID uint64, code *InviteCodeRecord) error
+	// RemoveInviteCode revokes the invite code of the channel.
+	RemoveInviteCode(channelID uint64, code string) error
+	// InviteCodes returns all invite codes of the channel, including expired ones.
+	InviteCodes(channelID uint64) ([]*InviteCodeRecord, error)
+
+	// SaveRevokedToken creates or overwrites the revoked token with the same Id.
+	SaveRevokedToken(token *RevokedTokenRecord) error
+	// RemoveRevokedToken forgets the revoked token, once it has expired.
+	RemoveRevokedToken(id string) error
+	// RevokedTokens returns all revoked tokens, including expired ones.
+	RevokedTokens() ([]*RevokedTokenRecord, error)
+
+	// SaveUserRevocation creates or overwrites the revocation of all the
+	// sessions of the user.
+	SaveUserRevocation(revocation *UserRevocationRecord) error
+	// RemoveUserRevocation forgets the revocation of sessions of the user,
+	// once all the tokens revoked by it have expired.
+	RemoveUserRevocation(username string) error
+	// UserRevocations returns revocations of sessions of all users.
+	UserRevocations() ([]*UserRevocationRecord, error)
+
+	// Close releases all the resources held by the storage.
+	Close() error
+}
+
+func cloneRolesWithPermission(src map[Permission][]Role) map[Permission][]Role {
+	dst := make(map[Permission][]Role, len(src))
+	for perm, roles := range src {
+		dst[perm] = append([]Role(nil), roles...)
+	}
+	return dst
+}
+
+// cloneMessageRecord returns a deep copy of the message.
+func cloneMessageRecord(msg *MessageRecord) MessageRecord {
+	record := *msg
+	if msg.Reactions != nil {
+		record.Reactions = make([]ReactionRecord, len(msg.Reactions))
+		for i, reaction := range msg.Reactions {
+			record.Reactions[i] = ReactionRecord{
+				Emoji:     reaction.Emoji,
+				Usernames: append([]string(nil), reaction.Usernames...),
+			}
+		}
+	}
+	return record
+}
+
+func reverseMessageRecords(records []*MessageRecord) {
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+}
+
+func cloneCustomRoles(src map[Role]string) map[Role]string {
+	dst := make(map[Role]string, len(src))
+	for role, name := range src {
+		dst[role] = name
+	}
+	return dst
+}
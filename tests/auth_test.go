@@ -0,0 +1,308 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestLogout checks that revoked tokens are rejected by the server, and that
+// users can revoke either one or all of their sessions.
+func TestLogout(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c1 := accord.NewAccordClient(serverID)
+	c1.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c1.CreateUser(username, password))
+	require.NoError(t, c1.Login(username, password))
+	require.NoError(t, c1.GetChannels())
+
+	// the revoked token cannot be used anymore, not even to log out again
+	token, refreshToken, err := c1.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	require.NoError(t, c1.AuthClient().Logout(token, refreshToken, false))
+	err = c1.AuthClient().Logout(token, "", false)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, _, err = c1.AuthClient().Refresh(refreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// other sessions are still valid
+	require.NoError(t, c1.GetChannels())
+
+	c2 := accord.NewAccordClient(serverID)
+	c2.Connect(serverAddr)
+	require.NoError(t, c2.Login(username, password))
+	require.NoError(t, c2.GetChannels())
+
+	// logging out of all sessions revokes the tokens of both clients
+	token, _, err = c1.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	_, refreshToken, err = c1.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	require.NoError(t, c1.AuthClient().Logout(token, "", true))
+	err = c1.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = c2.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, _, err = c1.AuthClient().Refresh(refreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// but new sessions are not affected
+	require.NoError(t, c2.Login(username, password))
+	require.NoError(t, c2.GetChannels())
+
+	require.NoError(t, c2.Logout(false))
+	require.Error(t, c2.GetChannels())
+	require.Error(t, c2.Logout(false))
+}
+
+// TestRevocationsSurviveRestart checks that tokens revoked by logging out stay
+// revoked after the server is restarted with the same storage.
+func TestRevocationsSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	storage := accord.NewMemoryStorage()
+	config := accord.DefaultServerConfig()
+	config.JWT.Secret = accord.GetRandPassword()
+	startServer := func() string {
+		s, err := accord.NewAccordServerWithConfig(config, storage)
+		require.NoError(t, err)
+		serverAddr, err := s.Listen("localhost:0")
+		require.NoError(t, err)
+		go func() {
+			s.Start()
+			t.Log("Server stopped.")
+		}()
+		return serverAddr
+	}
+
+	c := accord.NewAccordClient(12345)
+	require.NoError(t, c.Connect(startServer()))
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	token, refreshToken, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	require.NoError(t, c.AuthClient().Logout(token, refreshToken, false))
+	// logging out of all sessions revokes older sessions by the time they
+	// have been issued, and the ones issued in the same second by their Ids
+	oldToken, oldRefreshToken, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	time.Sleep(time.Second)
+	lastToken, lastRefreshToken, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	require.NoError(t, c.AuthClient().Logout(lastToken, "", true))
+
+	c = accord.NewAccordClient(12345)
+	require.NoError(t, c.Connect(startServer()))
+	for _, token := range []string{token, oldToken, lastToken} {
+		err = c.AuthClient().Logout(token, "", false)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	for _, refreshToken := range []string{refreshToken, oldRefreshToken, lastRefreshToken} {
+		_, _, err = c.AuthClient().Refresh(refreshToken)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	// but new sessions are not affected
+	require.NoError(t, c.Login(username, password))
+	require.NoError(t, c.GetChannels())
+}
+
+// TestExpiredRevocations checks that revocations are forgotten, once all the
+// tokens revoked by them have expired.
+func TestExpiredRevocations(t *testing.T) {
+	t.Parallel()
+
+	storage := accord.NewMemoryStorage()
+	now := time.Now()
+	require.NoError(t, storage.SaveRevokedToken(&accord.RevokedTokenRecord{ID: "expired", ExpiresAt: now.Add(-time.Minute)}))
+	token := &accord.RevokedTokenRecord{ID: "token", ExpiresAt: now.Add(time.Minute)}
+	require.NoError(t, storage.SaveRevokedToken(token))
+	// tokens issued before the revocation live at most an hour, so they have
+	// all expired
+	require.NoError(t, storage.SaveUserRevocation(&accord.UserRevocationRecord{Username: "alice", NotBefore: now.Add(-2 * time.Hour)}))
+	revocation := &accord.UserRevocationRecord{Username: "bob", NotBefore: now.Add(-time.Minute)}
+	require.NoError(t, storage.SaveUserRevocation(revocation))
+
+	denylist := accord.NewTokenDenylist(storage, time.Hour)
+	defer denylist.Stop()
+	require.NoError(t, denylist.Load())
+	tokens, err := storage.RevokedTokens()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.RevokedTokenRecord{token}, tokens)
+	revocations, err := storage.UserRevocations()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.UserRevocationRecord{revocation}, revocations)
+
+	require.True(t, denylist.IsRevoked(&accord.UserClaims{
+		Username:       "bob",
+		StandardClaims: jwt.StandardClaims{IssuedAt: now.Add(-time.Hour).Unix()},
+	}))
+	require.False(t, denylist.IsRevoked(&accord.UserClaims{
+		Username:       "alice",
+		StandardClaims: jwt.StandardClaims{IssuedAt: now.Add(-3 * time.Hour).Unix()},
+	}))
+}
+
+// TestLogoutWhileStreaming checks that streams with channels are closed by
+// logging out, and that they can be opened again after logging in.
+func TestLogoutWhileStreaming(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c := accord.NewAccordClient(12345)
+	require.NoError(t, c.Connect(serverAddr))
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	require.NoError(t, c.Login(username, password))
+	channelID, err := c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, c.GetChannel(channelID))
+	resComm, err := c.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, resComm)
+
+	require.NoError(t, c.Logout(false))
+	requireStreamClosed(t, resComm)
+
+	require.NoError(t, c.Login(username, password))
+	resComm, err = c.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "back"})
+	receive(t, resComm)
+}
+
+// TestRefresh checks that refresh tokens can be exchanged for new tokens only
+// once, and that the two kinds of tokens cannot be used instead of each other.
+func TestRefresh(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c := accord.NewAccordClient(serverID)
+	c.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+
+	accessToken, refreshToken, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+	require.NotEmpty(t, refreshToken)
+
+	// access tokens cannot be used for refreshing
+	_, _, err = c.AuthClient().Refresh(accessToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	// and refresh tokens cannot be used for authentication
+	err = c.AuthClient().Logout(refreshToken, "", false)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	newAccessToken, newRefreshToken, err := c.AuthClient().Refresh(refreshToken)
+	require.NoError(t, err)
+	require.NotEqual(t, accessToken, newAccessToken)
+	require.NotEqual(t, refreshToken, newRefreshToken)
+
+	// refresh tokens are rotated
+	_, _, err = c.AuthClient().Refresh(refreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, _, err = c.AuthClient().Refresh(newRefreshToken)
+	require.NoError(t, err)
+
+	// both the old and the new access tokens are valid until they expire
+	require.NoError(t, c.AuthClient().Logout(accessToken, "", false))
+	require.NoError(t, c.AuthClient().Logout(newAccessToken, "", false))
+}
+
+// fakeServerStream is the server side of a stream with the given context.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// TestInterceptorClaims checks that handlers get the identity of the user
+// only from the verified token, and not from metadata sent by the client.
+func TestInterceptorClaims(t *testing.T) {
+	t.Parallel()
+
+	jwtManager := accord.NewJWTManager("secret", time.Minute, time.Hour)
+	denylist := accord.NewTokenDenylist(accord.NewMemoryStorage(), time.Hour)
+	defer denylist.Stop()
+	interceptor := accord.NewServerAuthInterceptor(jwtManager, denylist)
+	token, err := jwtManager.Generate("alice")
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"authorization", token,
+		"username", "mallory",
+	))
+
+	var username string
+	err = interceptor.Stream()(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/accord.Chat/ChannelStream"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			claims, ok := accord.ClaimsFromContext(stream.Context())
+			require.True(t, ok)
+			username = claims.Username
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, "alice", username)
+
+	_, err = interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/accord.Chat/GetChannels"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := accord.ClaimsFromContext(ctx)
+			require.True(t, ok)
+			username = claims.Username
+			return nil, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, "alice", username)
+
+	// without a token, metadata alone doesn't authenticate anyone
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("username", "alice"))
+	_, err = interceptor.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/accord.Chat/GetChannels"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			require.FailNow(t, "handler must not be called")
+			return nil, nil
+		})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, ok := accord.ClaimsFromContext(ctx)
+	require.False(t, ok)
+}
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestServerConfig checks that options from the config file override the
+// defaults, and that environment variables and flags override the file.
+func TestServerConfig(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+		"listen_address": "localhost:1234",
+		"jwt": {"secret_file": "/etc/accord/secret", "token_ttl": "5m"},
+		"storage": {"path": "/var/lib/accord.db"}
+	}`), 0600))
+
+	config, err := accord.LoadServerConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, "localhost:1234", config.ListenAddress)
+	require.Equal(t, "/etc/accord/secret", config.JWT.SecretFile)
+	require.Equal(t, accord.Duration(5*time.Minute), config.JWT.TokenTTL)
+	require.Equal(t, accord.DefaultServerConfig().JWT.RefreshTokenTTL, config.JWT.RefreshTokenTTL)
+	// default paths are relative to the directory of the file as well
+	tlsConfig := accord.DefaultServerConfig().TLS
+	tlsConfig.CertFile = filepath.Join(dir, tlsConfig.CertFile)
+	tlsConfig.KeyFile = filepath.Join(dir, tlsConfig.KeyFile)
+	tlsConfig.CAFile = filepath.Join(dir, tlsConfig.CAFile)
+	require.Equal(t, tlsConfig, config.TLS)
+	require.Equal(t, "/var/lib/accord.db", config.Storage.Path)
+
+	env := map[string]string{
+		"ACCORD_LISTEN_ADDRESS": "localhost:4321",
+		"ACCORD_TOKEN_TTL":      "1m",
+		"ACCORD_STORAGE_PATH":   "",
+	}
+	require.NoError(t, config.ApplyEnv(func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}))
+	require.Equal(t, "localhost:4321", config.ListenAddress)
+	require.Equal(t, accord.Duration(time.Minute), config.JWT.TokenTTL)
+	require.Equal(t, "", config.Storage.Path)
+	require.Equal(t, "/etc/accord/secret", config.JWT.SecretFile)
+
+	require.NoError(t, config.Set("tls-cert-file", "/etc/accord/cert.pem"))
+	require.Equal(t, "/etc/accord/cert.pem", config.TLS.CertFile)
+	require.NoError(t, config.Validate())
+
+	require.Error(t, config.Set("token-ttl", "forever"))
+	require.Error(t, config.Set("unknown", "value"))
+	require.NoError(t, config.Set("token-ttl", "10000h"))
+	require.Error(t, config.Validate())
+	require.NoError(t, config.Set("token-ttl", "1m"))
+
+	require.NoError(t, config.Set("stream-queue-size", "16"))
+	require.NoError(t, config.Set("stream-overflow-policy", accord.OverflowDropOldest))
+	require.Equal(t, accord.StreamsConfig{QueueSize: 16, OverflowPolicy: accord.OverflowDropOldest}, config.Streams)
+	require.NoError(t, config.Validate())
+	require.Error(t, config.Set("stream-queue-size", "many"))
+	require.NoError(t, config.Set("stream-overflow-policy", "block"))
+	require.Error(t, config.Validate())
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"jwt": {"token_ttl": 5}}`), 0600))
+	_, err = accord.LoadServerConfig(path)
+	require.Error(t, err)
+}
+
+// TestServerConfigPaths checks that relative paths in the config file are
+// relative to its directory, and that missing files are reported by name.
+// Resolution of default paths is checked by TestServerConfig.
+func TestServerConfigPaths(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"server-cert.pem", "server-key.pem", "ca-cert.pem"} {
+		data, err := ioutil.ReadFile(filepath.Join("../cert", name))
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), data, 0600))
+	}
+	path := filepath.Join(dir, "config.json")
+	caPath := filepath.Join(dir, "ca-cert.pem")
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf(`{
+		"tls": {"cert_file": "server-cert.pem", "key_file": "server-key.pem", "ca_file": %q},
+		"storage": {"path": "data/accord.db"}
+	}`, caPath)), 0600))
+
+	config, err := accord.LoadServerConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "server-cert.pem"), config.TLS.CertFile)
+	require.Equal(t, filepath.Join(dir, "server-key.pem"), config.TLS.KeyFile)
+	require.Equal(t, caPath, config.TLS.CAFile)
+	require.Equal(t, filepath.Join(dir, "data/accord.db"), config.Storage.Path)
+	require.Equal(t, accord.DefaultServerConfig().JWT.SecretFile, config.JWT.SecretFile)
+	_, err = accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.NoError(t, err)
+
+	config.TLS.KeyFile = "missing-key.pem"
+	_, err = accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing-key.pem")
+	require.Contains(t, err.Error(), "working directory")
+
+	c := accord.NewAccordClient(12345)
+	err = c.ConnectWithCA("localhost:0", filepath.Join(dir, "missing-ca.pem"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing-ca.pem")
+}
+
+// TestConfiguredJWT checks that tokens are signed with the configured secret
+// and live as long as configured.
+func TestConfiguredJWT(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	secretPath := filepath.Join(dir, "secret")
+	require.NoError(t, ioutil.WriteFile(secretPath, []byte("not so secret\n"), 0600))
+
+	config := accord.DefaultServerConfig()
+	config.JWT.SecretFile = secretPath
+	config.JWT.TokenTTL = accord.Duration(time.Minute)
+	storage := accord.NewMemoryStorage()
+
+	serverID := uint64(12345)
+	s1, err := accord.NewAccordServerWithConfig(config, storage)
+	require.NoError(t, err)
+	serverAddr1, err := s1.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s1.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c := accord.NewAccordClient(serverID)
+	c.Connect(serverAddr1)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	accessToken, _, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+
+	claims := &jwt.StandardClaims{}
+	_, err = jwt.ParseWithClaims(accessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("not so secret"), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(time.Minute/time.Second), claims.ExpiresAt-claims.IssuedAt)
+
+	// the token is also accepted by another server with the same secret
+	s2, err := accord.NewAccordServerWithConfig(config, storage)
+	require.NoError(t, err)
+	serverAddr2, err := s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s2.Start()
+		t.Log("Server stopped.")
+	}()
+	c.Connect(serverAddr2)
+	require.NoError(t, c.AuthClient().Logout(accessToken, "", false))
+
+	// but not by the server with the default config
+	s3 := accord.NewAccordServer()
+	serverAddr3, err := s3.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s3.Start()
+		t.Log("Server stopped.")
+	}()
+	c.Connect(serverAddr3)
+	err = c.AuthClient().Logout(accessToken, "", false)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
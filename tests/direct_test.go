@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qvntm/accord"
+	pb "github.com/qvntm/accord/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newLoggedInClient connects a client of a new random user to the server.
+func newLoggedInClient(t *testing.T, serverAddr string) (*accord.AccordClient, string) {
+	c := accord.NewAccordClient(12345)
+	require.NoError(t, c.Connect(serverAddr))
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	require.NoError(t, c.Login(username, password))
+	return c, username
+}
+
+// TestDirectChannels checks that direct channels are opened once for each set
+// of users, and that they are hidden from and cannot be joined by others.
+func TestDirectChannels(t *testing.T) {
+	t.Parallel()
+
+	storage := accord.NewMemoryStorage()
+	s, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	alice, aliceName := newLoggedInClient(t, serverAddr)
+	bob := accord.NewAccordClient(12345)
+	require.NoError(t, bob.Connect(serverAddr))
+	bobName := accord.GetRandUsername()
+	bobPassword := accord.GetRandPassword()
+	require.NoError(t, bob.CreateUser(bobName, bobPassword))
+	require.NoError(t, bob.Login(bobName, bobPassword))
+	carol, _ := newLoggedInClient(t, serverAddr)
+	aliceEvents, err := alice.SubscribeToServer()
+	require.NoError(t, err)
+	carolEvents, err := carol.SubscribeToServer()
+	require.NoError(t, err)
+
+	dmID, err := alice.OpenDirectChannel(bobName)
+	require.NoError(t, err)
+	action := receiveServerEvent(t, aliceEvents).GetChannelAction()
+	require.Equal(t, dmID, action.ChannelID)
+	require.True(t, action.GetAddChannel().IsDirect)
+	require.False(t, action.GetAddChannel().IsPublic)
+
+	// the same users always get the same channel
+	id, err := alice.OpenDirectChannel(bobName, aliceName, bobName)
+	require.NoError(t, err)
+	require.Equal(t, dmID, id)
+	id, err = bob.OpenDirectChannel(aliceName)
+	require.NoError(t, err)
+	require.Equal(t, dmID, id)
+
+	groupID, err := carol.OpenDirectChannel(aliceName, bobName)
+	require.NoError(t, err)
+	require.NotEqual(t, dmID, groupID)
+	require.Equal(t, groupID, receiveServerEvent(t, aliceEvents).GetChannelAction().ChannelID)
+	// carol hasn't been told about the first channel
+	require.Equal(t, groupID, receiveServerEvent(t, carolEvents).GetChannelAction().ChannelID)
+
+	require.NoError(t, alice.GetChannels())
+	require.True(t, alice.Channels[dmID].IsDirect)
+	require.True(t, alice.Channels[groupID].IsDirect)
+	require.NoError(t, carol.GetChannels())
+	require.NotContains(t, carol.Channels, dmID)
+	require.Contains(t, carol.Channels, groupID)
+
+	require.NoError(t, bob.GetChannel(dmID))
+	require.Equal(t, map[string]accord.Role{aliceName: accord.MemberRole, bobName: accord.MemberRole}, bob.Channels[dmID].Users)
+	require.False(t, bob.Channels[dmID].IsPublic)
+	require.Equal(t, codes.PermissionDenied, status.Code(carol.GetChannel(dmID)))
+
+	// others cannot join the channel by streaming with it
+	stream, err := carol.ChatClient.ChannelStream(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ChannelStreamRequest{
+		ChannelId: dmID,
+		Msg: &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMsg{
+					NewUserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMessage{Content: "let me in"},
+				},
+			},
+		},
+	}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// members stream with the channel as with any other
+	require.NoError(t, alice.GetChannel(dmID))
+	aliceComm, err := alice.Subscribe(dmID)
+	require.NoError(t, err)
+	bobComm, err := bob.Subscribe(dmID)
+	require.NoError(t, err)
+	sendUserMessage(t, bob, dmID, &accord.NewMessageUserChannelStreamRequest{Content: "ping"})
+	receive(t, bobComm)
+	sendUserMessage(t, alice, dmID, &accord.NewMessageUserChannelStreamRequest{Content: "pong"})
+	res := receive(t, bobComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, aliceName, res.Sender)
+	require.Equal(t, "pong", res.GetNewAndUpdateUserMsg().Content)
+	receive(t, aliceComm)
+
+	_, err = alice.OpenDirectChannel()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = alice.OpenDirectChannel(aliceName)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = alice.OpenDirectChannel(accord.GetRandUsername())
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// direct channels are found again after the server is restarted
+	s2, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err = s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s2.Start()
+		t.Log("Server stopped.")
+	}()
+	bob = accord.NewAccordClient(12345)
+	require.NoError(t, bob.Connect(serverAddr))
+	require.NoError(t, bob.Login(bobName, bobPassword))
+	id, err = bob.OpenDirectChannel(aliceName)
+	require.NoError(t, err)
+	require.Equal(t, dmID, id)
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	pb "github.com/qvntm/accord/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func sendTyping(t *testing.T, c *accord.AccordClient, channelID uint64, stopped bool) {
+	require.NoError(t, c.Send(&accord.ChannelStreamRequest{
+		ChannelID: channelID,
+		Msg: &accord.EphemeralMessage{
+			Msg: &accord.TypingEphemeralMessage{Stopped: stopped},
+		},
+	}))
+}
+
+// TestTypingIndicators checks that typing signals are sent only to other
+// users, that they are throttled, and that they expire.
+func TestTypingIndicators(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, ownerComm)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+
+	before := time.Now()
+	sendTyping(t, member, channelID, false)
+	event := receive(t, ownerComm).Msg.(*accord.EphemeralMessage)
+	require.Equal(t, memberName, event.Username)
+	typing := event.GetTypingMsg()
+	require.NotNil(t, typing)
+	require.False(t, typing.Stopped)
+	require.True(t, typing.ExpiresAt.After(before))
+	require.True(t, typing.ExpiresAt.Before(before.Add(time.Minute)))
+
+	// repeated signals are throttled, and senders don't get their own signals
+	sendTyping(t, member, channelID, false)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "are you typing?"})
+	require.NotNil(t, receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg())
+	require.NotNil(t, receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg())
+
+	sendTyping(t, member, channelID, true)
+	event = receive(t, ownerComm).Msg.(*accord.EphemeralMessage)
+	require.Equal(t, memberName, event.Username)
+	require.True(t, event.GetTypingMsg().Stopped)
+
+	// the user isn't typing anymore, so stopping again changes nothing
+	sendTyping(t, member, channelID, true)
+	sendTyping(t, member, channelID, false)
+	event = receive(t, ownerComm).Msg.(*accord.EphemeralMessage)
+	require.False(t, event.GetTypingMsg().Stopped)
+
+	// sending a message ends typing, so the next signal isn't throttled
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "yes"})
+	receive(t, ownerComm)
+	receive(t, memberComm)
+	sendTyping(t, member, channelID, false)
+	event = receive(t, ownerComm).Msg.(*accord.EphemeralMessage)
+	require.False(t, event.GetTypingMsg().Stopped)
+
+	// typing signals are not stored
+	_, err = owner.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, owner.Channels[channelID].Messages, 3)
+
+	// the user, whose stream is closed, isn't typing anymore, so stopping
+	// later changes nothing
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := member.ChatClient.ChannelStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ChannelStreamRequest{
+		ChannelId: channelID,
+		Msg:       &pb.ChannelStreamRequest_SubscribeMsg{SubscribeMsg: &pb.ChannelStreamRequest_SubscribeMessage{}},
+	}))
+	require.NoError(t, stream.CloseSend())
+	for err == nil {
+		_, err = stream.Recv()
+	}
+	require.Equal(t, io.EOF, err)
+	sendTyping(t, member, channelID, true)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "gone?"})
+	require.NotNil(t, receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg())
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+)
+
+// publicKeyFromJWK converts the JWK back to the public key.
+func publicKeyFromJWK(t *testing.T, jwk accord.JSONWebKey) interface{} {
+	decode := func(s string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+	switch jwk.Kty {
+	case "RSA":
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(decode(jwk.N)),
+			E: int(new(big.Int).SetBytes(decode(jwk.E)).Int64()),
+		}
+	case "EC":
+		require.Equal(t, "P-256", jwk.Crv)
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(decode(jwk.X)),
+			Y:     new(big.Int).SetBytes(decode(jwk.Y)),
+		}
+	case "OKP":
+		require.Equal(t, "Ed25519", jwk.Crv)
+		return ed25519.PublicKey(decode(jwk.X))
+	}
+	require.FailNow(t, "unexpected key type", jwk.Kty)
+	return nil
+}
+
+// verifyWithKeySet verifies the token like other services would do it,
+// i.e. only with the exported public keys.
+func verifyWithKeySet(t *testing.T, set accord.JSONWebKeySet, token string) error {
+	_, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		for _, jwk := range set.Keys {
+			if jwk.Kid == token.Header["kid"] && jwk.Alg == token.Method.Alg() {
+				return publicKeyFromJWK(t, jwk), nil
+			}
+		}
+		return nil, jwt.ErrInvalidKey
+	})
+	return err
+}
+
+// TestJWTKeyRotation checks that tokens signed with retired keys are valid
+// only during the overlap, and that public keys of all valid keys are exported.
+func TestJWTKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSigningKey, err := accord.NewSigningKey(rsaKey)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSigningKey, err := accord.NewSigningKey(ecKey)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSigningKey, err := accord.NewSigningKey(edKey)
+	require.NoError(t, err)
+
+	manager, err := accord.NewJWTManagerWithKey(rsaSigningKey, time.Minute, time.Hour)
+	require.NoError(t, err)
+	rsaToken, err := manager.Generate("alice")
+	require.NoError(t, err)
+	token, _, err := new(jwt.Parser).ParseUnverified(rsaToken, &accord.UserClaims{})
+	require.NoError(t, err)
+	require.Equal(t, rsaSigningKey.ID, token.Header["kid"])
+	require.Equal(t, "RS256", token.Method.Alg())
+
+	require.NoError(t, manager.RotateKey(ecSigningKey, time.Hour))
+	ecToken, err := manager.Generate("alice")
+	require.NoError(t, err)
+	claims, err := manager.Verify(ecToken)
+	require.NoError(t, err)
+	require.Equal(t, "alice", claims.Username)
+	_, err = manager.Verify(rsaToken)
+	require.NoError(t, err)
+
+	// the ECDSA key is retired without any overlap
+	require.NoError(t, manager.RotateKey(edSigningKey, 0))
+	edToken, err := manager.Generate("alice")
+	require.NoError(t, err)
+	_, err = manager.Verify(edToken)
+	require.NoError(t, err)
+	_, err = manager.Verify(ecToken)
+	require.Error(t, err)
+	_, err = manager.Verify(rsaToken)
+	require.NoError(t, err)
+	require.Error(t, manager.RotateKey(rsaSigningKey, time.Hour))
+
+	set := manager.PublicKeySet()
+	require.Len(t, set.Keys, 2)
+	require.NoError(t, verifyWithKeySet(t, set, rsaToken))
+	require.NoError(t, verifyWithKeySet(t, set, edToken))
+	require.Error(t, verifyWithKeySet(t, set, ecToken))
+
+	// the public key cannot be used as an HMAC secret
+	publicKey, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, &accord.UserClaims{Username: "alice"})
+	forged.Header["kid"] = rsaSigningKey.ID
+	forgedToken, err := forged.SignedString(publicKey)
+	require.NoError(t, err)
+	_, err = manager.Verify(forgedToken)
+	require.Error(t, err)
+
+	// and tokens without a key Id are rejected
+	unknown := jwt.NewWithClaims(jwt.SigningMethodRS256, &accord.UserClaims{Username: "alice"})
+	unknownToken, err := unknown.SignedString(rsaKey)
+	require.NoError(t, err)
+	_, err = manager.Verify(unknownToken)
+	require.Error(t, err)
+
+	// HMAC secrets are never exported
+	hmacManager := accord.NewJWTManager("secret", time.Minute, time.Hour)
+	require.Empty(t, hmacManager.PublicKeySet().Keys)
+}
+
+// TestServerKeySet checks that tokens issued by the server configured with
+// a private key can be verified with the public key set of the server.
+func TestServerKeySet(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	// the retired key, which has signed tokens before
+	retiredKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err = x509.MarshalPKIXPublicKey(retiredKey)
+	require.NoError(t, err)
+	retiredPath := filepath.Join(dir, "retired.pem")
+	require.NoError(t, ioutil.WriteFile(retiredPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	config := accord.DefaultServerConfig()
+	config.JWT.KeyFile = keyPath
+	config.JWT.VerificationKeyFiles = []string{retiredPath}
+	s, err := accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	serverID := uint64(12345)
+	c := accord.NewAccordClient(serverID)
+	c.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	accessToken, refreshToken, err := c.AuthClient().Login(username, password)
+	require.NoError(t, err)
+
+	set, err := c.AuthClient().GetKeySet()
+	require.NoError(t, err)
+	require.Len(t, set.Keys, 2)
+	require.NoError(t, verifyWithKeySet(t, set, accessToken))
+	require.NoError(t, verifyWithKeySet(t, set, refreshToken))
+
+	// tokens are still accepted by the server itself
+	require.NoError(t, c.Login(username, password))
+	require.NoError(t, c.GetChannels())
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countGoroutines returns the number of goroutines, which are running any of
+// the functions.
+func countGoroutines(functions ...string) int {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	count := 0
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		for _, function := range functions {
+			if strings.Contains(stack, function) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// requireGoroutinesCount waits until the number of goroutines running any of
+// the functions drops to the expected one.
+func requireGoroutinesCount(t *testing.T, expected int, functions ...string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		count := countGoroutines(functions...)
+		if count == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			require.FailNowf(t, "goroutines are leaked", "%d goroutines are running %v, expected %d", count, functions, expected)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestChannelRemoval checks that streams with removed channels are closed
+// after the final event, and that no goroutines of the channel are left.
+// It doesn't run in parallel, so that goroutines of other tests don't change.
+func TestChannelRemoval(t *testing.T) {
+	channelFunctions := []string{"accord.(*ServerChannel).listen", "accord.(*AccordServer).ChannelStream"}
+	before := countGoroutines(channelFunctions...)
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	member, memberName := newLoggedInClient(t, serverAddr)
+	var channelIDs []uint64
+	for i := 0; i < 3; i++ {
+		channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+		require.NoError(t, err)
+		channelIDs = append(channelIDs, channelID)
+	}
+	channelID := channelIDs[0]
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, memberComm)
+	receive(t, ownerComm)
+	require.Equal(t, before+len(channelIDs)+4, countGoroutines(channelFunctions...))
+
+	require.NoError(t, owner.RemoveChannel(channelID))
+	for _, resComm := range []*accord.StreamResponseCommunication{ownerComm, memberComm} {
+		removed := receive(t, resComm).Msg.(*accord.ChannelRemovedMessage)
+		require.Equal(t, ownerName, removed.RemovedBy)
+		requireStreamClosed(t, resComm)
+	}
+	requireGoroutinesCount(t, before+len(channelIDs)-1, channelFunctions...)
+
+	// removed channels cannot be streamed with
+	_, err = member.Subscribe(channelID)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = member.RequestToJoin(channelID, accord.MemberRole)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for _, channelID := range channelIDs[1:] {
+		require.NoError(t, owner.RemoveChannel(channelID))
+	}
+	requireGoroutinesCount(t, before, channelFunctions...)
+}
@@ -0,0 +1,369 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	pb "github.com/qvntm/accord/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// joinChannel makes the user of the client a member of the public channel by
+// a request to join, which is approved by the admin. Users of resComms are
+// expected to be streaming with the channel, and to be told about the new member.
+func joinChannel(t *testing.T, admin *accord.AccordClient, c *accord.AccordClient, username string, channelID uint64, resComms ...*accord.StreamResponseCommunication) {
+	require.NoError(t, c.RequestToJoin(channelID, accord.MemberRole))
+	require.NoError(t, admin.ReviewJoinRequest(channelID, username, true))
+	for _, resComm := range resComms {
+		role := receive(t, resComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.RoleChannelConfigMessage)
+		require.Equal(t, username, role.Username)
+		require.Equal(t, accord.MemberRole, role.Role)
+	}
+}
+
+// TestInvites checks that private channels are joined by accepting invites,
+// which can only be sent by users who can assign roles.
+func TestInvites(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	invitee, inviteeName := newLoggedInClient(t, serverAddr)
+	other, otherName := newLoggedInClient(t, serverAddr)
+	channelName := accord.GetRandChannelName()
+	channelID, err := owner.CreateChannel(channelName, false)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+
+	// private channels cannot be joined otherwise
+	require.Equal(t, codes.PermissionDenied, status.Code(invitee.GetChannel(channelID)))
+	err = invitee.RequestToJoin(channelID, accord.MemberRole)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	stream, err := invitee.ChatClient.ChannelStream(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ChannelStreamRequest{
+		ChannelId: channelID,
+		Msg: &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMsg{
+					NewUserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMessage{Content: "let me in"},
+				},
+			},
+		},
+	}))
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, owner.InviteUser(channelID, inviteeName, accord.AdminRole))
+	require.NoError(t, owner.InviteUser(channelID, otherName, accord.MemberRole))
+	invites, err := invitee.GetInvites()
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	require.Equal(t, channelID, invites[0].ChannelID)
+	require.Equal(t, channelName, invites[0].ChannelName)
+	require.Equal(t, accord.AdminRole, invites[0].Role)
+	require.Equal(t, ownerName, invites[0].InvitedBy)
+	require.False(t, invites[0].InvitedAt.IsZero())
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Len(t, owner.Channels[channelID].Invites, 2)
+	require.Equal(t, accord.MemberRole, owner.Channels[channelID].Invites[otherName].Role)
+
+	// members are told about users, who accept invites
+	require.NoError(t, invitee.RespondToInvite(channelID, true))
+	role := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.RoleChannelConfigMessage)
+	require.Equal(t, inviteeName, role.Username)
+	require.Equal(t, accord.AdminRole, role.Role)
+	require.NoError(t, invitee.GetChannel(channelID))
+	require.Equal(t, accord.AdminRole, invitee.Channels[channelID].Users[inviteeName])
+	invites, err = invitee.GetInvites()
+	require.NoError(t, err)
+	require.Empty(t, invites)
+	err = invitee.RespondToInvite(channelID, true)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	err = owner.InviteUser(channelID, inviteeName, accord.MemberRole)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// admins cannot assign roles, so they cannot invite
+	err = invitee.InviteUser(channelID, otherName, accord.MemberRole)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, other.RespondToInvite(channelID, false))
+	require.Equal(t, codes.PermissionDenied, status.Code(other.GetChannel(channelID)))
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Empty(t, owner.Channels[channelID].Invites)
+
+	err = owner.InviteUser(channelID, accord.GetRandUsername(), accord.MemberRole)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	dmID, err := owner.OpenDirectChannel(inviteeName)
+	require.NoError(t, err)
+	err = owner.InviteUser(dmID, otherName, accord.MemberRole)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestJoinRequests checks that users become members of public channels only
+// after their requests to join are approved.
+func TestJoinRequests(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, _ := newLoggedInClient(t, serverAddr)
+	user, username := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+
+	// streaming doesn't make the user a member, who could write
+	require.NoError(t, user.GetChannel(channelID))
+	userComm, err := user.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, user, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, userComm).Code)
+
+	require.NoError(t, user.RequestToJoin(channelID, accord.MemberRole))
+	require.NoError(t, owner.GetChannel(channelID))
+	joinRequest := owner.Channels[channelID].JoinRequests[username]
+	require.Equal(t, accord.MemberRole, joinRequest.Role)
+	require.False(t, joinRequest.RequestedAt.IsZero())
+	require.NotContains(t, owner.Channels[channelID].Users, username)
+	err = user.ReviewJoinRequest(channelID, username, true)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, owner.ReviewJoinRequest(channelID, username, false))
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Empty(t, owner.Channels[channelID].JoinRequests)
+	require.NotContains(t, owner.Channels[channelID].Users, username)
+	err = owner.ReviewJoinRequest(channelID, username, true)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	joinChannel(t, owner, user, username, channelID, ownerComm)
+	userComm, err = user.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, user, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	require.Equal(t, "hi", receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg().Content)
+	receive(t, userComm)
+	err = user.RequestToJoin(channelID, accord.MemberRole)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+// TestInviteCodes checks that invite codes let users join private channels
+// until they expire, are used up or are revoked.
+func TestInviteCodes(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), false)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	code, err := owner.CreateInviteCode(channelID, accord.AdminRole, expiresAt, 2)
+	require.NoError(t, err)
+	require.NotEmpty(t, code.Code)
+	require.Equal(t, channelID, code.ChannelID)
+	require.Equal(t, accord.AdminRole, code.Role)
+	require.Equal(t, ownerName, code.CreatedBy)
+	require.True(t, expiresAt.Equal(code.ExpiresAt))
+	require.Equal(t, uint32(2), code.MaxUses)
+	require.Zero(t, code.Uses)
+
+	user, username := newLoggedInClient(t, serverAddr)
+	id, err := user.RedeemInviteCode(code.Code)
+	require.NoError(t, err)
+	require.Equal(t, channelID, id)
+	role := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.RoleChannelConfigMessage)
+	require.Equal(t, username, role.Username)
+	require.Equal(t, accord.AdminRole, role.Role)
+	redeemed := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.InviteCodeRedeemedChannelConfigMessage)
+	require.Equal(t, code.Code, redeemed.Code)
+	require.Equal(t, username, redeemed.Username)
+	require.False(t, redeemed.RedeemedAt.IsZero())
+	require.NoError(t, user.GetChannel(channelID))
+	require.Equal(t, accord.AdminRole, user.Channels[channelID].Users[username])
+
+	// members don't use codes up
+	_, err = user.RedeemInviteCode(code.Code)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	second, secondName := newLoggedInClient(t, serverAddr)
+	_, err = second.RedeemInviteCode(code.Code)
+	require.NoError(t, err)
+	receive(t, ownerComm)
+	receive(t, ownerComm)
+	third, _ := newLoggedInClient(t, serverAddr)
+	_, err = third.RedeemInviteCode(code.Code)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// codes are only managed by users who can assign roles
+	_, err = user.CreateInviteCode(channelID, accord.MemberRole, time.Time{}, 0)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = user.GetInviteCodes(channelID)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	unlimited, err := owner.CreateInviteCode(channelID, accord.MemberRole, time.Time{}, 0)
+	require.NoError(t, err)
+	member, _ := newLoggedInClient(t, serverAddr)
+	_, err = member.RedeemInviteCode(unlimited.Code)
+	require.NoError(t, err)
+	receive(t, ownerComm)
+	receive(t, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+
+	// redemptions are sent only to users, who can see invite codes
+	fourth, fourthName := newLoggedInClient(t, serverAddr)
+	_, err = fourth.RedeemInviteCode(unlimited.Code)
+	require.NoError(t, err)
+	receive(t, ownerComm)
+	redeemed = receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.InviteCodeRedeemedChannelConfigMessage)
+	require.Equal(t, fourthName, redeemed.Username)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome again"})
+	receive(t, ownerComm)
+	role = receive(t, memberComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.RoleChannelConfigMessage)
+	require.Equal(t, fourthName, role.Username)
+	require.NotNil(t, receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg())
+
+	inviteCodes, err := owner.GetInviteCodes(channelID)
+	require.NoError(t, err)
+	require.Len(t, inviteCodes, 2)
+	require.Equal(t, code.Code, inviteCodes[0].Code)
+	require.Equal(t, uint32(2), inviteCodes[0].Uses)
+	require.Len(t, inviteCodes[0].Redemptions, 2)
+	require.Equal(t, username, inviteCodes[0].Redemptions[0].Username)
+	require.Equal(t, secondName, inviteCodes[0].Redemptions[1].Username)
+	require.False(t, inviteCodes[0].Redemptions[1].RedeemedAt.Before(inviteCodes[0].Redemptions[0].RedeemedAt))
+	require.Equal(t, unlimited.Code, inviteCodes[1].Code)
+	require.True(t, inviteCodes[1].ExpiresAt.IsZero())
+	require.Equal(t, uint32(2), inviteCodes[1].Uses)
+
+	require.NoError(t, owner.RevokeInviteCode(channelID, unlimited.Code))
+	_, err = third.RedeemInviteCode(unlimited.Code)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	err = owner.RevokeInviteCode(channelID, unlimited.Code)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = owner.CreateInviteCode(channelID, accord.MemberRole, time.Now().Add(-time.Minute), 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	expiring, err := owner.CreateInviteCode(channelID, accord.MemberRole, time.Now().Add(200*time.Millisecond), 0)
+	require.NoError(t, err)
+	time.Sleep(300 * time.Millisecond)
+	_, err = third.RedeemInviteCode(expiring.Code)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestConcurrentInviteCodeRedemption checks that invite codes are never used
+// more times than they are allowed to.
+func TestConcurrentInviteCodeRedemption(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, _ := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), false)
+	require.NoError(t, err)
+	code, err := owner.CreateInviteCode(channelID, accord.MemberRole, time.Time{}, 3)
+	require.NoError(t, err)
+
+	users := make([]*accord.AccordClient, 8)
+	for i := range users {
+		users[i], _ = newLoggedInClient(t, serverAddr)
+	}
+	errc := make(chan error, len(users))
+	for _, user := range users {
+		go func(user *accord.AccordClient) {
+			_, err := user.RedeemInviteCode(code.Code)
+			errc <- err
+		}(user)
+	}
+	var joined int
+	for range users {
+		if err := <-errc; err == nil {
+			joined++
+		} else {
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		}
+	}
+	require.Equal(t, 3, joined)
+
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Len(t, owner.Channels[channelID].Users, 4)
+	inviteCodes, err := owner.GetInviteCodes(channelID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), inviteCodes[0].Uses)
+}
+
+// TestSubscribeWithoutJoining checks that users, who haven't joined public
+// channels, receive their updates without sending anything.
+func TestSubscribeWithoutJoining(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	reader, readerName := newLoggedInClient(t, serverAddr)
+	require.NoError(t, reader.GetChannel(channelID))
+	require.NotContains(t, reader.Channels[channelID].Users, readerName)
+	readerComm, err := reader.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "news"})
+	receive(t, ownerComm)
+	news := receive(t, readerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, ownerName, news.Sender)
+	require.Equal(t, "news", news.GetNewAndUpdateUserMsg().Content)
+}
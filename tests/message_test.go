@@ -0,0 +1,518 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	pb "github.com/qvntm/accord/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// receive waits for the next response from the channel stream. Presence
+// changes, which are sent whenever users connect or disconnect, are skipped.
+func receive(t *testing.T, resComm *accord.StreamResponseCommunication) *accord.ChannelStreamResponse {
+	for {
+		res := receiveAny(t, resComm)
+		if event, ok := res.Msg.(*accord.EphemeralMessage); !ok || event.GetPresenceMsg() == nil {
+			return res
+		}
+	}
+}
+
+// receiveAny waits for the next response from the channel stream.
+func receiveAny(t *testing.T, resComm *accord.StreamResponseCommunication) *accord.ChannelStreamResponse {
+	select {
+	case res, ok := <-resComm.Resc:
+		require.True(t, ok, "channel stream has been closed")
+		return res
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for channel stream response")
+	}
+	return nil
+}
+
+func sendUserMessage(t *testing.T, c *accord.AccordClient, channelID uint64, msg interface{}) {
+	req := &accord.ChannelStreamRequest{ChannelID: channelID}
+	switch m := msg.(type) {
+	case *accord.NewMessageUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	case *accord.EditMessageUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	case *accord.DeleteMessageUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	case *accord.AddReactionUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	case *accord.RemoveReactionUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	case *accord.MarkReadUserChannelStreamRequest:
+		req.Msg = &accord.UserChannelStreamRequest{UserMsg: m}
+	default:
+		require.FailNow(t, "unexpected user message type")
+	}
+	require.NoError(t, c.Send(req))
+}
+
+// TestChannelMessageLog checks that messages get increasing Ids, which are
+// never reused, and that they can be edited and deleted afterwards.
+func TestChannelMessageLog(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c := accord.NewAccordClient(serverID)
+	c.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	require.NoError(t, c.Login(username, password))
+
+	channelID, err := c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, c.GetChannel(channelID))
+	resComm, err := c.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "first"})
+	first := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "first", first.GetNewAndUpdateUserMsg().Content)
+
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "second"})
+	second := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "second", second.GetNewAndUpdateUserMsg().Content)
+	require.Greater(t, second.MessageID, first.MessageID)
+
+	sendUserMessage(t, c, channelID, &accord.EditMessageUserChannelStreamRequest{
+		MessageID: first.MessageID,
+		Content:   "edited",
+	})
+	edited := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, first.MessageID, edited.MessageID)
+	require.Equal(t, "edited", edited.GetNewAndUpdateUserMsg().Content)
+	require.True(t, first.GetNewAndUpdateUserMsg().Timestamp.Equal(edited.GetNewAndUpdateUserMsg().Timestamp))
+
+	sendUserMessage(t, c, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: second.MessageID})
+	deleted := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, second.MessageID, deleted.MessageID)
+	require.NotNil(t, deleted.GetDeleteUserMsg())
+
+	// editing of deleted message fails without broadcasting, and Id of
+	// deleted message is not reused by the next one
+	sendUserMessage(t, c, channelID, &accord.EditMessageUserChannelStreamRequest{
+		MessageID: second.MessageID,
+		Content:   "resurrected",
+	})
+	requireRejected(t, resComm)
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "third"})
+	third := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "third", third.GetNewAndUpdateUserMsg().Content)
+	require.Greater(t, third.MessageID, second.MessageID)
+}
+
+// TestChannelHistory checks that a user, who joins the channel later, can
+// page through the messages sent before.
+func TestChannelHistory(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c1 := accord.NewAccordClient(serverID)
+	c1.Connect(serverAddr)
+	username1 := accord.GetRandUsername()
+	password1 := accord.GetRandPassword()
+	require.NoError(t, c1.CreateUser(username1, password1))
+	require.NoError(t, c1.Login(username1, password1))
+
+	channelID, err := c1.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, c1.GetChannel(channelID))
+	resComm, err := c1.Subscribe(channelID)
+	require.NoError(t, err)
+
+	var ids []uint64
+	for _, content := range []string{"one", "two", "three", "four", "five"} {
+		sendUserMessage(t, c1, channelID, &accord.NewMessageUserChannelStreamRequest{Content: content})
+		ids = append(ids, receive(t, resComm).Msg.(*accord.UserChannelStreamResponse).MessageID)
+	}
+	sendUserMessage(t, c1, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: ids[2]})
+	receive(t, resComm)
+
+	c2 := accord.NewAccordClient(serverID)
+	c2.Connect(serverAddr)
+	username2 := accord.GetRandUsername()
+	password2 := accord.GetRandPassword()
+	require.NoError(t, c2.CreateUser(username2, password2))
+	require.NoError(t, c2.Login(username2, password2))
+	require.NoError(t, c2.GetChannels())
+
+	// newest page
+	n, err := c2.GetMessages(channelID, 0, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	messages := c2.Channels[channelID].Messages
+	require.Equal(t, []uint64{ids[3], ids[4]}, []uint64{messages[0].MessageID, messages[1].MessageID})
+	require.Equal(t, "four", messages[0].Content)
+
+	// older page skips the deleted message
+	n, err = c2.GetMessages(channelID, ids[3], 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	n, err = c2.GetMessages(channelID, ids[0], 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	messages = c2.Channels[channelID].Messages
+	require.Len(t, messages, 4)
+	for i, id := range []uint64{ids[0], ids[1], ids[3], ids[4]} {
+		require.Equal(t, id, messages[i].MessageID)
+	}
+
+	// forward page
+	c3 := accord.NewAccordClient(serverID)
+	c3.Connect(serverAddr)
+	require.NoError(t, c3.Login(username2, password2))
+	require.NoError(t, c3.GetChannels())
+	n, err = c3.GetMessages(channelID, 0, ids[0], 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	messages = c3.Channels[channelID].Messages
+	require.Equal(t, []uint64{ids[1], ids[3]}, []uint64{messages[0].MessageID, messages[1].MessageID})
+}
+
+// TestMessageSender checks that messages are stamped with their authors, who
+// are the only ones allowed to edit them, and that messages of others can be
+// deleted only with DeletePermission.
+func TestMessageSender(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "rules"})
+	rules := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, ownerName, rules.Sender)
+	require.Equal(t, ownerName, receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse).Sender)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	hi := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, memberName, hi.Sender)
+	require.Equal(t, memberName, receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse).Sender)
+
+	// the username sent by the client is ignored
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "username", ownerName)
+	stream, err := member.ChatClient.ChannelStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ChannelStreamRequest{
+		ChannelId: channelID,
+		Msg: &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMsg{
+					NewUserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMessage{Content: "spoofed"},
+				},
+			},
+		},
+	}))
+	spoofed := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "spoofed", spoofed.GetNewAndUpdateUserMsg().Content)
+	require.Equal(t, memberName, spoofed.Sender)
+	require.NoError(t, stream.CloseSend())
+
+	// authors are returned with the history
+	_, err = owner.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	messages := owner.Channels[channelID].Messages
+	require.Len(t, messages, 3)
+	require.Equal(t, ownerName, messages[0].Sender)
+	require.Equal(t, memberName, messages[1].Sender)
+	require.Equal(t, memberName, messages[2].Sender)
+
+	// members can delete their own messages
+	sendUserMessage(t, member, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: spoofed.MessageID})
+	deleted := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, spoofed.MessageID, deleted.MessageID)
+	require.Equal(t, memberName, deleted.Sender)
+
+	// but not edit or delete messages of others, which doesn't close their streams
+	sendUserMessage(t, member, channelID, &accord.EditMessageUserChannelStreamRequest{MessageID: rules.MessageID, Content: "no rules"})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+	sendUserMessage(t, member, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: rules.MessageID})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "sorry"})
+	res := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "sorry", res.GetNewAndUpdateUserMsg().Content)
+	require.Equal(t, memberName, res.Sender)
+
+	// not even superadmins can edit messages of others, but they can delete them
+	sendUserMessage(t, owner, channelID, &accord.EditMessageUserChannelStreamRequest{MessageID: hi.MessageID, Content: "bye"})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, ownerComm).Code)
+	sendUserMessage(t, owner, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: hi.MessageID})
+	deleted = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, hi.MessageID, deleted.MessageID)
+	require.Equal(t, memberName, deleted.Sender)
+
+	// deleting the message again fails, and the stream still works
+	sendUserMessage(t, owner, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: hi.MessageID})
+	requireRejected(t, ownerComm)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "still here"})
+	res = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "still here", res.GetNewAndUpdateUserMsg().Content)
+}
+
+// TestThreads checks that replies are grouped into threads of the messages,
+// which they reply to, and that threads can be paged through.
+func TestThreads(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c := accord.NewAccordClient(serverID)
+	c.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, c.CreateUser(username, password))
+	require.NoError(t, c.Login(username, password))
+	channelID, err := c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, c.GetChannel(channelID))
+	resComm, err := c.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "root"})
+	root := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Zero(t, root.ThreadRootID)
+
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "first", ReplyTo: root.MessageID})
+	first := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, root.MessageID, first.ReplyTo)
+	require.Equal(t, root.MessageID, first.ThreadRootID)
+
+	// replies to replies are added to the same thread
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "second", ReplyTo: first.MessageID})
+	second := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, first.MessageID, second.ReplyTo)
+	require.Equal(t, root.MessageID, second.ThreadRootID)
+
+	sendUserMessage(t, c, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: first.MessageID})
+	deleted := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, root.MessageID, deleted.ThreadRootID)
+
+	// deleted messages cannot be replied to
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "lost", ReplyTo: first.MessageID})
+	requireRejected(t, resComm)
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "other"})
+	other := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "other", other.GetNewAndUpdateUserMsg().Content)
+	require.Zero(t, other.ThreadRootID)
+
+	sendUserMessage(t, c, channelID, &accord.EditMessageUserChannelStreamRequest{MessageID: root.MessageID, Content: "edited"})
+	edited := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, uint32(1), edited.GetNewAndUpdateUserMsg().ReplyCount)
+	require.True(t, second.GetNewAndUpdateUserMsg().Timestamp.Equal(edited.GetNewAndUpdateUserMsg().LastReplyAt))
+
+	_, err = c.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	channel := c.Channels[channelID]
+	require.Len(t, channel.Messages, 3)
+	require.Equal(t, uint32(1), channel.Messages[0].ReplyCount)
+	require.Equal(t, root.MessageID, channel.Messages[1].ThreadRootID)
+	require.Len(t, channel.Threads, 1)
+	require.Equal(t, uint32(1), channel.Threads[root.MessageID].ReplyCount)
+	require.Empty(t, channel.Threads[root.MessageID].Replies)
+
+	n, err := c.GetThread(channelID, root.MessageID, 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	thread := channel.Threads[root.MessageID]
+	require.Len(t, thread.Replies, 1)
+	require.Equal(t, second.MessageID, thread.Replies[0].MessageID)
+	require.Equal(t, "second", thread.Replies[0].Content)
+	require.True(t, thread.LastReplyAt.Equal(thread.Replies[0].Timestamp))
+
+	// deleting the last reply moves the time of the last reply back
+	sendUserMessage(t, c, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "third", ReplyTo: root.MessageID})
+	third := receive(t, resComm).Msg.(*accord.UserChannelStreamResponse)
+	sendUserMessage(t, c, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: third.MessageID})
+	receive(t, resComm)
+	_, err = c.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), c.Channels[channelID].Messages[0].ReplyCount)
+	require.True(t, second.GetNewAndUpdateUserMsg().Timestamp.Equal(c.Channels[channelID].Messages[0].LastReplyAt))
+	sendUserMessage(t, c, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: second.MessageID})
+	receive(t, resComm)
+	_, err = c.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	require.Zero(t, c.Channels[channelID].Messages[0].ReplyCount)
+	require.True(t, c.Channels[channelID].Messages[0].LastReplyAt.IsZero())
+
+	// only roots of threads can be paged through
+	_, err = c.GetThread(channelID, second.MessageID, 0, 0, 0)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.GetThread(channelID, 100, 0, 0, 0)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestReactions checks that reactions are aggregated per emoji, that adding
+// and removing them is idempotent, and that they are kept with the message.
+func TestReactions(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "vote"})
+	msg := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+
+	sendUserMessage(t, owner, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "👍"})
+	reactions := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).GetReactionsMsg()
+	require.NotNil(t, reactions)
+	require.Equal(t, []accord.Reaction{{Emoji: "👍", Count: 1, Usernames: []string{ownerName}}}, reactions.Reactions)
+
+	sendUserMessage(t, member, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "👍"})
+	receive(t, memberComm)
+	res := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, msg.MessageID, res.MessageID)
+	require.Equal(t, ownerName, res.Sender)
+	require.Equal(t, []accord.Reaction{{Emoji: "👍", Count: 2, Usernames: []string{ownerName, memberName}}}, res.GetReactionsMsg().Reactions)
+
+	// reacting twice with the same emoji changes nothing
+	sendUserMessage(t, member, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "👍"})
+	receive(t, ownerComm)
+	sendUserMessage(t, member, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "🎉"})
+	receive(t, ownerComm)
+	sendUserMessage(t, owner, channelID, &accord.RemoveReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "👍"})
+	reactions = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).GetReactionsMsg()
+	require.Equal(t, []accord.Reaction{
+		{Emoji: "👍", Count: 1, Usernames: []string{memberName}},
+		{Emoji: "🎉", Count: 1, Usernames: []string{memberName}},
+	}, reactions.Reactions)
+	sendUserMessage(t, owner, channelID, &accord.RemoveReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "🎉"})
+	receive(t, ownerComm)
+
+	// invalid emoji are rejected without broadcasting
+	sendUserMessage(t, owner, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: ""})
+	requireRejected(t, ownerComm)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "other"})
+	other := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "other", other.GetNewAndUpdateUserMsg().Content)
+
+	// reactions are returned with the history
+	_, err = owner.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	messages := owner.Channels[channelID].Messages
+	require.Len(t, messages, 2)
+	require.Len(t, messages[0].Reactions, 2)
+	require.Equal(t, uint32(1), messages[0].Reactions[0].Count)
+	require.Equal(t, []string{memberName}, messages[0].Reactions[1].Usernames)
+	require.Empty(t, messages[1].Reactions)
+
+	// deleted messages cannot be reacted to
+	sendUserMessage(t, owner, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: msg.MessageID})
+	receive(t, ownerComm)
+	sendUserMessage(t, owner, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: msg.MessageID, Emoji: "👍"})
+	requireRejected(t, ownerComm)
+	sendUserMessage(t, owner, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: other.MessageID, Emoji: "👀"})
+	res = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, other.MessageID, res.MessageID)
+
+	// subscribers cannot react
+	receive(t, memberComm)
+	sendConfigMessage(t, owner, channelID, &accord.RoleChannelConfigMessage{Username: memberName, Role: accord.SubscriberRole})
+	receive(t, ownerComm)
+	receive(t, memberComm)
+	sendUserMessage(t, member, channelID, &accord.AddReactionUserChannelStreamRequest{MessageID: other.MessageID, Emoji: "👍"})
+	// the member hasn't read all of the reactions, which are left in the stream
+	for {
+		select {
+		case res, ok := <-memberComm.Resc:
+			require.True(t, ok, "channel stream has to stay open")
+			if errMsg, isErr := res.Msg.(*accord.ErrorMessage); isErr {
+				require.Equal(t, codes.PermissionDenied, errMsg.Code)
+				return
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for the error")
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/qvntm/accord"
+	pb "github.com/qvntm/accord/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requireStreamClosed waits until the channel stream is closed by the server.
+// Presence changes, which may be sent before, are skipped.
+func requireStreamClosed(t *testing.T, resComm *accord.StreamResponseCommunication) {
+	for {
+		select {
+		case res, ok := <-resComm.Resc:
+			if ok {
+				if event, isEvent := res.Msg.(*accord.EphemeralMessage); isEvent && event.GetPresenceMsg() != nil {
+					continue
+				}
+			}
+			require.False(t, ok, "channel stream has to be closed")
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for the stream to be closed")
+		}
+		return
+	}
+}
+
+// requireRejected waits for the error, with which the request sent through
+// the channel stream has been rejected or has failed, and returns it. Presence
+// changes, which may be sent before, are skipped.
+func requireRejected(t *testing.T, resComm *accord.StreamResponseCommunication) *accord.ErrorMessage {
+	for {
+		select {
+		case res, ok := <-resComm.Resc:
+			require.True(t, ok, "channel stream has to stay open")
+			if event, isEvent := res.Msg.(*accord.EphemeralMessage); isEvent && event.GetPresenceMsg() != nil {
+				continue
+			}
+			errMsg, isErr := res.Msg.(*accord.ErrorMessage)
+			require.True(t, isErr, "expected an error, got %v", res.Msg)
+			return errMsg
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for the error")
+		}
+	}
+}
+
+// TestKickAndBan checks that kicked users are removed from the channel and
+// can join it again, while banned users cannot join until they are unbanned.
+func TestKickAndBan(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	storage := accord.NewMemoryStorage()
+	s, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	subscribe := func() *accord.StreamResponseCommunication {
+		memberComm, err := member.Subscribe(channelID)
+		require.NoError(t, err)
+		sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+		receive(t, memberComm)
+		receive(t, ownerComm)
+		return memberComm
+	}
+	memberComm := subscribe()
+
+	// members can neither kick nor see bans
+	require.NoError(t, member.GetChannel(channelID))
+	require.Empty(t, member.Channels[channelID].Bans)
+	sendConfigMessage(t, member, channelID, &accord.KickChannelConfigMessage{Username: ownerName})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+
+	// the kicked user is notified before the stream is closed
+	sendConfigMessage(t, owner, channelID, &accord.KickChannelConfigMessage{Username: memberName})
+	kick := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.KickChannelConfigMessage)
+	require.Equal(t, memberName, kick.Username)
+	receive(t, memberComm)
+	requireStreamClosed(t, memberComm)
+	require.NoError(t, owner.GetChannel(channelID))
+	require.NotContains(t, owner.Channels[channelID].Users, memberName)
+
+	// kicked users can join again
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	memberComm = subscribe()
+
+	sendConfigMessage(t, owner, channelID, &accord.BanChannelConfigMessage{
+		Ban: accord.Ban{Username: memberName, Reason: "spam"},
+	})
+	ban := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.BanChannelConfigMessage).Ban
+	require.Equal(t, memberName, ban.Username)
+	require.Equal(t, ownerName, ban.BannedBy)
+	require.False(t, ban.BannedAt.IsZero())
+	require.True(t, ban.ExpiresAt.IsZero())
+	receive(t, memberComm)
+	requireStreamClosed(t, memberComm)
+
+	require.NoError(t, owner.GetChannel(channelID))
+	require.NotContains(t, owner.Channels[channelID].Users, memberName)
+	require.Contains(t, owner.Channels[channelID].Bans, memberName)
+	require.Equal(t, "spam", owner.Channels[channelID].Bans[memberName].Reason)
+
+	// banned users can neither join nor read the channel
+	err = member.GetChannel(channelID)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = member.RequestToJoin(channelID, accord.MemberRole)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = member.Subscribe(channelID)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// bans survive restarts of the server
+	s2, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr2, err := s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s2.Start()
+		t.Log("Server stopped.")
+	}()
+	owner2 := accord.NewAccordClient(serverID)
+	owner2.Connect(serverAddr2)
+	require.NoError(t, owner2.Login(ownerName, ownerPassword))
+	require.NoError(t, owner2.GetChannel(channelID))
+	require.Equal(t, ownerName, owner2.Channels[channelID].Bans[memberName].BannedBy)
+
+	// unbanned users can join again
+	sendConfigMessage(t, owner, channelID, &accord.UnbanChannelConfigMessage{Username: memberName})
+	unban := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.UnbanChannelConfigMessage)
+	require.Equal(t, memberName, unban.Username)
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	subscribe()
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Equal(t, accord.MemberRole, owner.Channels[channelID].Users[memberName])
+	require.Empty(t, owner.Channels[channelID].Bans)
+}
+
+// TestExpiredBan checks that bans, which have already expired, are rejected,
+// and that bans stop applying once they expire.
+func TestExpiredBan(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+
+	// bans, which have already expired, are rejected
+	memberName := accord.GetRandUsername()
+	expiredAt, err := ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := owner.ChatClient.ChannelStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ChannelStreamRequest{
+		ChannelId: channelID,
+		Msg: &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: &pb.ChannelConfigMessage_BanMsg{
+					BanMsg: &pb.ChannelConfigMessage_BanChannelConfigMessage{
+						Ban: &pb.Ban{Username: memberName, ExpiresAt: expiredAt},
+					},
+				},
+			},
+		},
+	}))
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.InvalidArgument), res.GetErrorMsg().GetCode())
+	require.NoError(t, stream.CloseSend())
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Empty(t, owner.Channels[channelID].Bans)
+
+	// and bans, which expire later, stop applying once they expire
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendConfigMessage(t, owner, channelID, &accord.BanChannelConfigMessage{
+		Ban: accord.Ban{Username: memberName, ExpiresAt: time.Now().Add(time.Second)},
+	})
+	receive(t, ownerComm)
+	time.Sleep(time.Second)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, memberComm)
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const caCertFile = "../cert/ca-cert.pem"
+
+// writeClientCert writes the client certificate with the common name signed by
+// the CA, or self-signed if ca is nil, and its key into dir.
+func writeClientCert(t *testing.T, dir string, ca *tls.Certificate, commonName string) (string, string) {
+	return writeCert(t, dir, ca, commonName, x509.ExtKeyUsageClientAuth)
+}
+
+// writeCert writes the certificate with the extended key usages the same way
+// as writeClientCert.
+func writeCert(t *testing.T, dir string, ca *tls.Certificate, commonName string, extKeyUsage ...x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  extKeyUsage,
+	}
+	parent, signer := template, interface{}(key)
+	if ca != nil {
+		parent, err = x509.ParseCertificate(ca.Certificate[0])
+		require.NoError(t, err)
+		signer = ca.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, commonName+"-cert.pem")
+	keyPath := filepath.Join(dir, commonName+"-key.pem")
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certPath, keyPath
+}
+
+func startServerWithClientAuth(t *testing.T, clientAuth string) string {
+	config := accord.DefaultServerConfig()
+	config.TLS.ClientAuth = clientAuth
+	config.TLS.ClientCAFile = caCertFile
+	s, err := accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+	return serverAddr
+}
+
+// TestClientCertificateAuth checks that clients with certificates signed by
+// the CA are authenticated as the user named in the certificate.
+func TestClientCertificateAuth(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ca, err := tls.LoadX509KeyPair(caCertFile, "../cert/ca-key.pem")
+	require.NoError(t, err)
+
+	serverID := uint64(12345)
+	serverAddr := startServerWithClientAuth(t, accord.ClientAuthOptional)
+
+	// the service account is created once, then it never logs in with the password
+	botname := accord.GetRandUsername()
+	admin := accord.NewAccordClient(serverID)
+	require.NoError(t, admin.Connect(serverAddr))
+	require.NoError(t, admin.CreateUser(botname, accord.GetRandPassword()))
+
+	certPath, keyPath := writeClientCert(t, dir, &ca, botname)
+	bot := accord.NewAccordClient(serverID)
+	require.NoError(t, bot.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	channelID, err := bot.CreateChannel("bots", true)
+	require.NoError(t, err)
+	require.NoError(t, bot.GetChannel(channelID))
+	require.Equal(t, accord.SuperadminRole, bot.Channels[channelID].Users[botname])
+
+	// password users on the same server are not affected
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	require.NoError(t, admin.CreateUser(username, password))
+	require.NoError(t, admin.Login(username, password))
+	require.NoError(t, admin.GetChannels())
+
+	// certificates of unknown users are rejected
+	certPath, keyPath = writeClientCert(t, dir, &ca, accord.GetRandUsername())
+	unknown := accord.NewAccordClient(serverID)
+	require.NoError(t, unknown.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	err = unknown.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// and so are certificates, which are not issued for client authentication,
+	// including the server's own one
+	certPath, keyPath = writeCert(t, dir, &ca, botname)
+	server := accord.NewAccordClient(serverID)
+	require.NoError(t, server.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	err = server.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	server = accord.NewAccordClient(serverID)
+	require.NoError(t, server.ConnectWithCertificate(serverAddr, caCertFile, "../cert/server-cert.pem", "../cert/server-key.pem"))
+	err = server.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// and certificates, which are not signed by the CA
+	certPath, keyPath = writeClientCert(t, dir, nil, botname+"-self-signed")
+	selfSigned := accord.NewAccordClient(serverID)
+	require.NoError(t, selfSigned.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	require.Error(t, selfSigned.GetChannels())
+
+	// the server doesn't accept certificates unless configured to
+	certPath, keyPath = writeClientCert(t, dir, &ca, botname)
+	serverAddr = startServerWithClientAuth(t, accord.ClientAuthNone)
+	bot = accord.NewAccordClient(serverID)
+	require.NoError(t, bot.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	err = bot.GetChannels()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// and requires them if configured to
+	serverAddr = startServerWithClientAuth(t, accord.ClientAuthRequire)
+	certPath, keyPath = writeClientCert(t, dir, nil, botname+"-self-signed")
+	selfSigned = accord.NewAccordClient(serverID)
+	require.NoError(t, selfSigned.ConnectWithCertificate(serverAddr, caCertFile, certPath, keyPath))
+	require.Error(t, selfSigned.CreateUser(accord.GetRandUsername(), accord.GetRandPassword()))
+
+	config := accord.DefaultServerConfig()
+	require.NoError(t, config.Set("tls-client-auth", "always"))
+	require.Error(t, config.Validate())
+	// the client CA has to be configured explicitly
+	config = accord.DefaultServerConfig()
+	require.NoError(t, config.Set("tls-client-auth", accord.ClientAuthOptional))
+	require.Error(t, config.Validate())
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestChannelPermissions checks that members can only do what their role
+// allows, and that rejected requests are never broadcasted.
+func TestChannelPermissions(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+
+	channelName := accord.GetRandChannelName()
+	channelID, err := owner.CreateChannel(channelName, true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	// the stream is registered by the server with the first request
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+
+	// members can write
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hello"})
+	res := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "hello", res.GetNewAndUpdateUserMsg().Content)
+	receive(t, memberComm)
+
+	// but cannot rename the channel
+	err = member.Send(&accord.ChannelStreamRequest{
+		ChannelID: channelID,
+		Msg: &accord.ChannelConfigMessage{
+			Msg: &accord.NameChannelConfigMessage{NewChannelName: accord.GetRandChannelName()},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+
+	// the rename has not been broadcasted, so the next response is the owner's message
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "still here"})
+	res = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "still here", res.GetNewAndUpdateUserMsg().Content)
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Equal(t, channelName, owner.Channels[channelID].Name)
+
+	// and cannot remove the channel
+	err = member.RemoveChannel(channelID)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NoError(t, owner.RemoveChannel(channelID))
+}
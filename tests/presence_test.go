@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// receivePresence waits for the next presence change from the channel stream.
+func receivePresence(t *testing.T, resComm *accord.StreamResponseCommunication) (string, accord.Presence) {
+	event, ok := receiveAny(t, resComm).Msg.(*accord.EphemeralMessage)
+	require.True(t, ok, "presence change is expected")
+	require.NotNil(t, event.GetPresenceMsg(), "presence change is expected")
+	return event.Username, event.GetPresenceMsg().Presence
+}
+
+// TestPresence checks that users are online while any of their streams is
+// open, and that presence changes are sent to channels the user is in.
+func TestPresence(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, ownerComm)
+
+	// the member uses two devices, the first one streams with the channel
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	phone := accord.NewAccordClient(serverID)
+	phone.Connect(serverAddr)
+	require.NoError(t, phone.CreateUser(memberName, memberPassword))
+	require.NoError(t, phone.Login(memberName, memberPassword))
+	joinChannel(t, owner, phone, memberName, channelID, ownerComm)
+	require.NoError(t, phone.GetChannel(channelID))
+	phoneComm, err := phone.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, phone, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hello"})
+	receive(t, phoneComm)
+	receive(t, ownerComm)
+
+	laptop := accord.NewAccordClient(serverID)
+	laptop.Connect(serverAddr)
+	require.NoError(t, laptop.Login(memberName, memberPassword))
+	_, err = laptop.SubscribeToServer()
+	require.NoError(t, err)
+
+	presence, err := laptop.SetPresence(accord.IdlePresenceStatus, "lunch")
+	require.NoError(t, err)
+	idle := accord.Presence{Status: accord.IdlePresenceStatus, CustomStatus: "lunch"}
+	require.Equal(t, idle, presence)
+	username, presence := receivePresence(t, ownerComm)
+	require.Equal(t, memberName, username)
+	require.Equal(t, idle, presence)
+
+	require.NoError(t, owner.GetChannel(channelID))
+	presences := owner.Channels[channelID].Presences
+	require.Equal(t, idle, presences[memberName])
+	require.Equal(t, accord.Presence{Status: accord.OnlinePresenceStatus}, presences[ownerName])
+
+	// the member stays connected through the laptop
+	require.NoError(t, phone.Logout(false))
+	_, err = laptop.SetPresence(accord.OnlinePresenceStatus, "lunch")
+	require.NoError(t, err)
+	username, presence = receivePresence(t, ownerComm)
+	require.Equal(t, memberName, username)
+	require.Equal(t, accord.Presence{Status: accord.OnlinePresenceStatus, CustomStatus: "lunch"}, presence)
+
+	// custom status is kept while the member is offline
+	require.NoError(t, laptop.Logout(false))
+	username, presence = receivePresence(t, ownerComm)
+	require.Equal(t, memberName, username)
+	require.Equal(t, accord.Presence{Status: accord.OfflinePresenceStatus, CustomStatus: "lunch"}, presence)
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Equal(t, presence, owner.Channels[channelID].Presences[memberName])
+
+	_, err = owner.SetPresence(accord.OfflinePresenceStatus, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = owner.SetPresence(accord.OnlinePresenceStatus, strings.Repeat("a", 1000))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestReadReceipts checks that last read messages of users are kept by the
+// server, and that unread messages and mentions are counted after them.
+func TestReadReceipts(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	var ids []uint64
+	for _, content := range []string{"hello", "hi @" + memberName + "!", "hi @" + memberName + "2"} {
+		sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: content})
+		ids = append(ids, receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).MessageID)
+	}
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	subscriber, _ := newLoggedInClient(t, serverAddr)
+	require.NoError(t, subscriber.GetChannel(channelID))
+	subscriberComm, err := subscriber.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendUserMessage(t, member, channelID, &accord.MarkReadUserChannelStreamRequest{MessageID: ids[0]})
+	res := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, ids[0], res.MessageID)
+	require.Equal(t, memberName, res.GetReadMsg().Username)
+	receive(t, memberComm)
+
+	require.NoError(t, member.GetChannels())
+	channel := member.Channels[channelID]
+	require.Equal(t, ids[0], channel.LastReadID)
+	require.Equal(t, int32(2), channel.UnreadCount)
+	require.Equal(t, int32(1), channel.MentionCount)
+
+	// own messages are never unread
+	require.NoError(t, owner.GetChannels())
+	require.Zero(t, owner.Channels[channelID].UnreadCount)
+
+	// the last read message doesn't move back
+	sendUserMessage(t, member, channelID, &accord.MarkReadUserChannelStreamRequest{MessageID: ids[2]})
+	receive(t, memberComm)
+	sendUserMessage(t, member, channelID, &accord.MarkReadUserChannelStreamRequest{MessageID: ids[1]})
+	res = receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, ids[2], res.MessageID)
+	require.NoError(t, member.GetChannels())
+	require.Equal(t, ids[2], member.Channels[channelID].LastReadID)
+	require.Zero(t, member.Channels[channelID].UnreadCount)
+	require.Zero(t, member.Channels[channelID].MentionCount)
+
+	// missing messages cannot be read
+	sendUserMessage(t, member, channelID, &accord.MarkReadUserChannelStreamRequest{MessageID: 100})
+	requireRejected(t, memberComm)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "read it"})
+	res = receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "read it", res.GetNewAndUpdateUserMsg().Content)
+
+	// subscribers don't receive read receipts, so the message is the first response to them
+	res = receive(t, subscriberComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, "read it", res.GetNewAndUpdateUserMsg().Content)
+
+	_, err = owner.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, owner.Channels[channelID].Messages[0].SeenBy)
+	_, err = owner.GetMessagesWithSeenBy(channelID, 0, 0, 0)
+	require.NoError(t, err)
+	messages := owner.Channels[channelID].Messages
+	require.Len(t, messages, 4)
+	for _, msg := range messages[:3] {
+		require.Equal(t, []string{memberName}, msg.SeenBy)
+	}
+	require.Empty(t, messages[3].SeenBy)
+
+	// neither can they see who has read messages
+	_, err = subscriber.GetMessagesWithSeenBy(channelID, 0, 0, 0)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = subscriber.GetMessages(channelID, 0, 0, 0)
+	require.NoError(t, err)
+}
+
+// TestUnreadCountsPastPage checks that all the unread messages are counted,
+// even if there are more of them than are read from the storage at once.
+func TestUnreadCountsPastPage(t *testing.T) {
+	t.Parallel()
+
+	storage := accord.NewMemoryStorage()
+	s, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	for i := 0; i < 1500; i++ {
+		content := "news"
+		if i%2 == 0 {
+			content = "hi @" + ownerName
+		}
+		_, err := storage.AppendMessage(channelID, &accord.MessageRecord{Sender: "someone", Content: content})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, owner.GetChannels())
+	require.Equal(t, int32(1500), owner.Channels[channelID].UnreadCount)
+	require.Equal(t, int32(750), owner.Channels[channelID].MentionCount)
+}
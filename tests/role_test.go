@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func sendConfigMessage(t *testing.T, c *accord.AccordClient, channelID uint64, msg interface{}) {
+	req := &accord.ChannelStreamRequest{ChannelID: channelID}
+	switch m := msg.(type) {
+	case *accord.NameChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.RoleChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.PinChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.DefineRoleChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.RemoveRoleChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.KickChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.BanChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	case *accord.UnbanChannelConfigMessage:
+		req.Msg = &accord.ChannelConfigMessage{Msg: m}
+	default:
+		require.FailNow(t, "unexpected config message type")
+	}
+	require.NoError(t, c.Send(req))
+}
+
+// TestCustomRoles checks that superadmins can define custom roles, assign
+// them to users, and that users get exactly the permissions of their roles.
+func TestCustomRoles(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner := accord.NewAccordClient(serverID)
+	owner.Connect(serverAddr)
+	ownerName := accord.GetRandUsername()
+	ownerPassword := accord.GetRandPassword()
+	require.NoError(t, owner.CreateUser(ownerName, ownerPassword))
+	require.NoError(t, owner.Login(ownerName, ownerPassword))
+
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "rules"})
+	rules := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+
+	sendConfigMessage(t, owner, channelID, &accord.DefineRoleChannelConfigMessage{
+		Definition: accord.RoleDefinition{
+			Name:        "moderator",
+			Permissions: []accord.Permission{accord.ReadPermission, accord.WritePermission, accord.DeletePermission},
+		},
+	})
+	defined := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.DefineRoleChannelConfigMessage)
+	moderator := defined.Definition.Role
+	require.True(t, moderator.IsCustom())
+	require.Equal(t, "moderator", defined.Definition.Name)
+
+	member := accord.NewAccordClient(serverID)
+	member.Connect(serverAddr)
+	memberName := accord.GetRandUsername()
+	memberPassword := accord.GetRandPassword()
+	require.NoError(t, member.CreateUser(memberName, memberPassword))
+	require.NoError(t, member.Login(memberName, memberPassword))
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, memberComm)
+	receive(t, ownerComm)
+
+	sendConfigMessage(t, owner, channelID, &accord.RoleChannelConfigMessage{Username: memberName, Role: moderator})
+	receive(t, ownerComm)
+	assigned := receive(t, memberComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.RoleChannelConfigMessage)
+	require.Equal(t, moderator, assigned.Role)
+
+	require.NoError(t, member.GetChannel(channelID))
+	channel := member.Channels[channelID]
+	require.Equal(t, moderator, channel.Users[memberName])
+	require.Equal(t, "moderator", channel.Roles[moderator].Name)
+	require.ElementsMatch(t, []accord.Permission{accord.ReadPermission, accord.WritePermission, accord.DeletePermission}, channel.Roles[moderator].Permissions)
+	require.Contains(t, channel.RolesWithPermission[accord.DeletePermission], moderator)
+	require.NotContains(t, channel.RolesWithPermission[accord.DeletePermission], accord.MemberRole)
+
+	// moderators can delete messages of others, unlike members
+	sendUserMessage(t, member, channelID, &accord.DeleteMessageUserChannelStreamRequest{MessageID: rules.MessageID})
+	deleted := receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse)
+	require.Equal(t, rules.MessageID, deleted.MessageID)
+	require.NotNil(t, deleted.GetDeleteUserMsg())
+	receive(t, memberComm)
+
+	// but they cannot manage roles
+	sendConfigMessage(t, member, channelID, &accord.DefineRoleChannelConfigMessage{
+		Definition: accord.RoleDefinition{Name: "god", Permissions: []accord.Permission{accord.RemoveChannelPermission}},
+	})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+
+	// removal of the role turns moderators into members
+	sendConfigMessage(t, owner, channelID, &accord.RemoveRoleChannelConfigMessage{Role: moderator})
+	receive(t, ownerComm)
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Equal(t, accord.MemberRole, owner.Channels[channelID].Users[memberName])
+	require.NotContains(t, owner.Channels[channelID].Roles, moderator)
+}
+
+// TestRoleEscalation checks that users, who can assign roles, cannot assign
+// roles with permissions, which they don't have themselves.
+func TestRoleEscalation(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, _ := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	sendConfigMessage(t, owner, channelID, &accord.DefineRoleChannelConfigMessage{
+		Definition: accord.RoleDefinition{
+			Name:        "assigner",
+			Permissions: []accord.Permission{accord.ReadPermission, accord.WritePermission, accord.AssignRolePermission},
+		},
+	})
+	assigner := receive(t, ownerComm).Msg.(*accord.ChannelConfigMessage).Msg.(*accord.DefineRoleChannelConfigMessage).Definition.Role
+
+	member, memberName := newLoggedInClient(t, serverAddr)
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	sendConfigMessage(t, owner, channelID, &accord.RoleChannelConfigMessage{Username: memberName, Role: assigner})
+	receive(t, ownerComm)
+
+	// admins can delete messages of others and kick users, unlike assigners
+	_, otherName := newLoggedInClient(t, serverAddr)
+	err = member.InviteUser(channelID, otherName, accord.AdminRole)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = member.CreateInviteCode(channelID, accord.AdminRole, time.Time{}, 0)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NoError(t, member.InviteUser(channelID, otherName, accord.MemberRole))
+
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendConfigMessage(t, member, channelID, &accord.RoleChannelConfigMessage{Username: memberName, Role: accord.AdminRole})
+	require.Equal(t, codes.PermissionDenied, requireRejected(t, memberComm).Code)
+
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Equal(t, assigner, owner.Channels[channelID].Users[memberName])
+}
@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+)
+
+// receiveServerEvent waits for the next server-wide event.
+func receiveServerEvent(t *testing.T, resComm *accord.ServerStreamResponseCommunication) *accord.ServerStreamResponse {
+	select {
+	case res, ok := <-resComm.Resc:
+		require.True(t, ok, "server stream has been closed")
+		return res
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for server stream response")
+	}
+	return nil
+}
+
+// TestServerStream checks that addition, renaming and removal of channels
+// are streamed to subscribed clients, which keep their channels up to date.
+func TestServerStream(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c1 := accord.NewAccordClient(serverID)
+	c1.Connect(serverAddr)
+	username1 := accord.GetRandUsername()
+	password1 := accord.GetRandPassword()
+	require.NoError(t, c1.CreateUser(username1, password1))
+	require.NoError(t, c1.Login(username1, password1))
+	serverComm, err := c1.SubscribeToServer()
+	require.NoError(t, err)
+
+	c2 := accord.NewAccordClient(serverID)
+	c2.Connect(serverAddr)
+	username2 := accord.GetRandUsername()
+	password2 := accord.GetRandPassword()
+	require.NoError(t, c2.CreateUser(username2, password2))
+	require.NoError(t, c2.Login(username2, password2))
+
+	channelName := accord.GetRandChannelName()
+	channelID, err := c2.CreateChannel(channelName, true)
+	require.NoError(t, err)
+
+	action := receiveServerEvent(t, serverComm).GetChannelAction()
+	require.Equal(t, channelID, action.ChannelID)
+	require.Equal(t, channelName, action.GetAddChannel().Name)
+	require.True(t, action.GetAddChannel().IsPublic)
+	require.Contains(t, c1.Channels, channelID)
+	require.Equal(t, channelName, c1.Channels[channelID].Name)
+
+	require.NoError(t, c2.GetChannel(channelID))
+	_, err = c2.Subscribe(channelID)
+	require.NoError(t, err)
+	newName := accord.GetRandChannelName()
+	err = c2.Send(&accord.ChannelStreamRequest{
+		ChannelID: channelID,
+		Msg: &accord.ChannelConfigMessage{
+			Msg: &accord.NameChannelConfigMessage{NewChannelName: newName},
+		},
+	})
+	require.NoError(t, err)
+
+	action = receiveServerEvent(t, serverComm).GetChannelAction()
+	require.Equal(t, channelID, action.ChannelID)
+	require.Equal(t, newName, action.GetRenameChannel().NewName)
+	require.Equal(t, newName, c1.Channels[channelID].Name)
+
+	require.NoError(t, c2.RemoveChannel(channelID))
+
+	action = receiveServerEvent(t, serverComm).GetChannelAction()
+	require.Equal(t, channelID, action.ChannelID)
+	require.NotNil(t, action.GetRemoveChannel())
+	require.NotContains(t, c1.Channels, channelID)
+}
+
+// TestSlowServerStreamConsumerDisconnected checks that clients, which don't read
+// server-wide events, hold up neither the creation of channels nor the other
+// subscribers, and that they are disconnected once they fall behind.
+func TestSlowServerStreamConsumerDisconnected(t *testing.T) {
+	t.Parallel()
+
+	config := accord.DefaultServerConfig()
+	config.Streams.QueueSize = 8
+	s, err := accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	stalled, _ := newLoggedInClient(t, serverAddr)
+	stalledComm, err := stalled.SubscribeToServer()
+	require.NoError(t, err)
+	c, _ := newLoggedInClient(t, serverAddr)
+	serverComm, err := c.SubscribeToServer()
+	require.NoError(t, err)
+
+	// large names fill the transport buffers of the stalled client
+	name := strings.Repeat("x", floodMessageBytes)
+	for i := 0; i < floodMessages; i++ {
+		channelID, err := c.CreateChannel(name, true)
+		require.NoError(t, err)
+		require.Equal(t, channelID, receiveServerEvent(t, serverComm).GetChannelAction().ChannelID)
+	}
+
+	received := 0
+	for range stalledComm.Resc {
+		received++
+	}
+	require.Less(t, received, floodMessages)
+}
+
+// TestServerStreamWhileFetchingChannels checks that channels of the client can
+// be fetched while they are updated by server-wide events.
+func TestServerStreamWhileFetchingChannels(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c1, _ := newLoggedInClient(t, serverAddr)
+	serverComm, err := c1.SubscribeToServer()
+	require.NoError(t, err)
+	c2, _ := newLoggedInClient(t, serverAddr)
+
+	const channels = 20
+	errc := make(chan error, 1)
+	go func() {
+		for i := 0; i < channels; i++ {
+			if _, err := c2.CreateChannel(accord.GetRandChannelName(), true); err != nil {
+				errc <- err
+				return
+			}
+		}
+		errc <- nil
+	}()
+	for i := 0; i < channels; i++ {
+		require.NoError(t, c1.GetChannels())
+		receiveServerEvent(t, serverComm)
+	}
+	require.NoError(t, <-errc)
+	require.NoError(t, c1.GetChannels())
+	require.Len(t, c1.Channels, channels)
+}
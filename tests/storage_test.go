@@ -0,0 +1,421 @@
+package tests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBoltStorageReopen checks that everything written to the on-disk
+// storage is still there after the database is closed and opened again.
+func TestBoltStorageReopen(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "accord.db")
+
+	storage, err := accord.NewBoltStorage(path)
+	require.NoError(t, err)
+
+	user := &accord.UserRecord{Username: accord.GetRandUsername(), HashedPassword: "hash"}
+	require.NoError(t, storage.SaveUser(user))
+
+	channel := &accord.ChannelRecord{
+		ChannelID: 7,
+		Name:      accord.GetRandChannelName(),
+		IsPublic:  true,
+		RolesWithPermission: map[accord.Permission][]accord.Role{
+			accord.WritePermission: {accord.MemberRole, accord.AdminRole},
+		},
+	}
+	require.NoError(t, storage.SaveChannel(channel))
+	member := &accord.ChannelUserRecord{Username: user.Username, Role: accord.AdminRole, LastReadID: 3}
+	require.NoError(t, storage.SaveChannelUser(channel.ChannelID, member))
+	ban := &accord.BanRecord{
+		Username:  accord.GetRandUsername(),
+		Reason:    "spam",
+		BannedBy:  user.Username,
+		BannedAt:  time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.SaveBan(channel.ChannelID, ban))
+	lifted := &accord.BanRecord{Username: accord.GetRandUsername()}
+	require.NoError(t, storage.SaveBan(channel.ChannelID, lifted))
+	require.NoError(t, storage.RemoveBan(channel.ChannelID, lifted.Username))
+	invite := &accord.MembershipRequestRecord{
+		Username:  accord.GetRandUsername(),
+		Role:      accord.AdminRole,
+		InvitedBy: user.Username,
+		CreatedAt: time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.SaveMembershipRequest(channel.ChannelID, invite))
+	declined := &accord.MembershipRequestRecord{Username: accord.GetRandUsername(), Role: accord.MemberRole}
+	require.NoError(t, storage.SaveMembershipRequest(channel.ChannelID, declined))
+	require.NoError(t, storage.RemoveMembershipRequest(channel.ChannelID, declined.Username))
+	code := &accord.InviteCodeRecord{
+		Code:      "code",
+		Role:      accord.MemberRole,
+		CreatedBy: user.Username,
+		CreatedAt: time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2020, 8, 2, 12, 0, 0, 0, time.UTC),
+		MaxUses:   10,
+		Uses:      3,
+		Redemptions: []accord.InviteCodeRedemptionRecord{
+			{Username: user.Username, RedeemedAt: time.Date(2020, 8, 1, 13, 0, 0, 0, time.UTC)},
+		},
+	}
+	require.NoError(t, storage.SaveInviteCode(channel.ChannelID, code))
+	require.NoError(t, storage.SaveInviteCode(channel.ChannelID, &accord.InviteCodeRecord{Code: "revoked"}))
+	require.NoError(t, storage.RemoveInviteCode(channel.ChannelID, "revoked"))
+	token := &accord.RevokedTokenRecord{ID: "token", ExpiresAt: time.Date(2020, 8, 2, 12, 0, 0, 0, time.UTC)}
+	require.NoError(t, storage.SaveRevokedToken(token))
+	require.NoError(t, storage.SaveRevokedToken(&accord.RevokedTokenRecord{ID: "expired"}))
+	require.NoError(t, storage.RemoveRevokedToken("expired"))
+	revocation := &accord.UserRevocationRecord{Username: user.Username, NotBefore: time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)}
+	require.NoError(t, storage.SaveUserRevocation(revocation))
+	require.NoError(t, storage.SaveUserRevocation(&accord.UserRevocationRecord{Username: "expired"}))
+	require.NoError(t, storage.RemoveUserRevocation("expired"))
+	msg := &accord.MessageRecord{
+		Timestamp: time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC),
+		Sender:    user.Username,
+		Content:   "hello",
+		Reactions: []accord.ReactionRecord{{Emoji: "👋", Usernames: []string{user.Username}}},
+	}
+	msg.MessageID, err = storage.AppendMessage(channel.ChannelID, msg)
+	require.NoError(t, err)
+
+	removed := &accord.ChannelRecord{ChannelID: 8, Name: accord.GetRandChannelName()}
+	require.NoError(t, storage.SaveChannel(removed))
+	require.NoError(t, storage.RemoveChannel(removed.ChannelID))
+	require.NoError(t, storage.Close())
+
+	storage, err = accord.NewBoltStorage(path)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	users, err := storage.Users()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.UserRecord{user}, users)
+
+	channels, err := storage.Channels()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.ChannelRecord{channel}, channels)
+
+	members, err := storage.ChannelUsers(channel.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, []*accord.ChannelUserRecord{member}, members)
+
+	bans, err := storage.Bans(channel.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, []*accord.BanRecord{ban}, bans)
+
+	requests, err := storage.MembershipRequests(channel.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, []*accord.MembershipRequestRecord{invite}, requests)
+
+	inviteCodes, err := storage.InviteCodes(channel.ChannelID)
+	require.NoError(t, err)
+	require.Equal(t, []*accord.InviteCodeRecord{code}, inviteCodes)
+
+	tokens, err := storage.RevokedTokens()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.RevokedTokenRecord{token}, tokens)
+
+	revocations, err := storage.UserRevocations()
+	require.NoError(t, err)
+	require.Equal(t, []*accord.UserRevocationRecord{revocation}, revocations)
+
+	stored, err := storage.Message(channel.ChannelID, msg.MessageID)
+	require.NoError(t, err)
+	require.Equal(t, msg, stored)
+
+	_, err = storage.ChannelUsers(removed.ChannelID)
+	require.NotNil(t, err)
+	_, err = storage.Bans(removed.ChannelID)
+	require.NotNil(t, err)
+	_, err = storage.MembershipRequests(removed.ChannelID)
+	require.NotNil(t, err)
+	_, err = storage.InviteCodes(removed.ChannelID)
+	require.NotNil(t, err)
+}
+
+// TestBoltStorageMessageIDs checks that message Ids keep increasing after
+// the database is closed and opened again.
+func TestBoltStorageMessageIDs(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "accord.db")
+
+	storage, err := accord.NewBoltStorage(path)
+	require.NoError(t, err)
+	channelID := uint64(1)
+	require.NoError(t, storage.SaveChannel(&accord.ChannelRecord{ChannelID: channelID}))
+
+	id1, err := storage.AppendMessage(channelID, &accord.MessageRecord{Content: "first"})
+	require.NoError(t, err)
+	id2, err := storage.AppendMessage(channelID, &accord.MessageRecord{Content: "second"})
+	require.NoError(t, err)
+	require.Greater(t, id2, id1)
+
+	msg, err := storage.Message(channelID, id2)
+	require.NoError(t, err)
+	msg.Content = ""
+	msg.Deleted = true
+	require.NoError(t, storage.UpdateMessage(channelID, msg))
+	require.NoError(t, storage.Close())
+
+	storage, err = accord.NewBoltStorage(path)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	id3, err := storage.AppendMessage(channelID, &accord.MessageRecord{Content: "third"})
+	require.NoError(t, err)
+	require.Greater(t, id3, id2)
+
+	msg, err = storage.Message(channelID, id2)
+	require.NoError(t, err)
+	require.True(t, msg.Deleted)
+	msg, err = storage.Message(channelID, id1)
+	require.NoError(t, err)
+	require.Equal(t, "first", msg.Content)
+}
+
+// TestStorageMessagesPaging checks range queries over message logs in all
+// storage backends.
+func TestStorageMessagesPaging(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	boltStorage, err := accord.NewBoltStorage(filepath.Join(dir, "accord.db"))
+	require.NoError(t, err)
+	defer boltStorage.Close()
+
+	storages := map[string]accord.Storage{
+		"memory": accord.NewMemoryStorage(),
+		"bolt":   boltStorage,
+	}
+	for name, storage := range storages {
+		channelID := uint64(3)
+		require.NoError(t, storage.SaveChannel(&accord.ChannelRecord{ChannelID: channelID}), name)
+		for i := 0; i < 6; i++ {
+			_, err := storage.AppendMessage(channelID, &accord.MessageRecord{Content: accord.RandString(5)})
+			require.NoError(t, err, name)
+		}
+		msg, err := storage.Message(channelID, 4)
+		require.NoError(t, err, name)
+		msg.Deleted = true
+		require.NoError(t, storage.UpdateMessage(channelID, msg), name)
+
+		tests := []struct {
+			afterID, beforeID uint64
+			limit             int
+			newest            bool
+			want              []uint64
+		}{
+			{0, 0, 3, true, []uint64{3, 5, 6}},
+			{0, 0, 10, true, []uint64{1, 2, 3, 5, 6}},
+			{0, 0, 2, false, []uint64{1, 2}},
+			{0, 5, 2, true, []uint64{2, 3}},
+			{2, 0, 2, false, []uint64{3, 5}},
+			{1, 6, 10, true, []uint64{2, 3, 5}},
+			{1, 6, 10, false, []uint64{2, 3, 5}},
+			{0, 100, 1, true, []uint64{6}},
+			{6, 0, 5, false, nil},
+			{0, 1, 5, true, nil},
+		}
+		for _, tt := range tests {
+			records, err := storage.Messages(channelID, tt.afterID, tt.beforeID, tt.limit, tt.newest)
+			require.NoError(t, err, name)
+			var got []uint64
+			for _, record := range records {
+				got = append(got, record.MessageID)
+			}
+			require.Equal(t, tt.want, got, "%s: %+v", name, tt)
+		}
+	}
+}
+
+// TestStorageThreadPaging checks range queries over replies in threads in
+// all storage backends.
+func TestStorageThreadPaging(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	boltStorage, err := accord.NewBoltStorage(filepath.Join(dir, "accord.db"))
+	require.NoError(t, err)
+	defer boltStorage.Close()
+
+	storages := map[string]accord.Storage{
+		"memory": accord.NewMemoryStorage(),
+		"bolt":   boltStorage,
+	}
+	for name, storage := range storages {
+		channelID := uint64(3)
+		require.NoError(t, storage.SaveChannel(&accord.ChannelRecord{ChannelID: channelID}), name)
+		// messages 1 and 2 start threads, 8 is not in any thread
+		for _, root := range []uint64{0, 0, 1, 2, 1, 1, 1, 0} {
+			_, err := storage.AppendMessage(channelID, &accord.MessageRecord{
+				Content:      accord.RandString(5),
+				ReplyTo:      root,
+				ThreadRootID: root,
+			})
+			require.NoError(t, err, name)
+		}
+		msg, err := storage.Message(channelID, 6)
+		require.NoError(t, err, name)
+		msg.Deleted = true
+		require.NoError(t, storage.UpdateMessage(channelID, msg), name)
+
+		tests := []struct {
+			threadRootID      uint64
+			afterID, beforeID uint64
+			limit             int
+			newest            bool
+			want              []uint64
+		}{
+			{1, 0, 0, 10, true, []uint64{3, 5, 7}},
+			{1, 0, 0, 2, true, []uint64{5, 7}},
+			{1, 0, 0, 2, false, []uint64{3, 5}},
+			{1, 0, 7, 10, true, []uint64{3, 5}},
+			{1, 3, 0, 10, false, []uint64{5, 7}},
+			{1, 3, 7, 10, false, []uint64{5}},
+			{2, 0, 0, 10, true, []uint64{4}},
+			{2, 0, 100, 10, true, []uint64{4}},
+			{8, 0, 0, 10, true, nil},
+			{1, 7, 0, 10, false, nil},
+		}
+		for _, tt := range tests {
+			records, err := storage.ThreadMessages(channelID, tt.threadRootID, tt.afterID, tt.beforeID, tt.limit, tt.newest)
+			require.NoError(t, err, name)
+			var got []uint64
+			for _, record := range records {
+				got = append(got, record.MessageID)
+			}
+			require.Equal(t, tt.want, got, "%s: %+v", name, tt)
+		}
+	}
+}
+
+// TestServerRehydration checks that a server created on top of the storage
+// used by another server restores users, channels and their members.
+func TestServerRehydration(t *testing.T) {
+	t.Parallel()
+
+	serverID := uint64(12345)
+	storage := accord.NewMemoryStorage()
+	s1, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err := s1.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s1.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c1 := accord.NewAccordClient(serverID)
+	c1.Connect(serverAddr)
+	username := accord.GetRandUsername()
+	password := accord.GetRandPassword()
+	err = c1.CreateUser(username, password)
+	require.NoError(t, err)
+	err = c1.Login(username, password)
+	require.NoError(t, err)
+
+	channelName := accord.GetRandChannelName()
+	channelID, err := c1.CreateChannel(channelName, true)
+	require.NoError(t, err)
+
+	// the second server only shares the storage with the first one
+	s2, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err = s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s2.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c2 := accord.NewAccordClient(serverID)
+	c2.Connect(serverAddr)
+	err = c2.Login(username, password)
+	require.NoError(t, err)
+
+	err = c2.GetChannels()
+	require.NoError(t, err)
+	require.Contains(t, c2.Channels, channelID)
+	require.Equal(t, channelName, c2.Channels[channelID].Name)
+
+	err = c2.GetChannel(channelID)
+	require.NoError(t, err)
+	require.Equal(t, accord.SuperadminRole, c2.Channels[channelID].Users[username])
+
+	// new channels must not reuse Ids of the restored ones
+	newChannelID, err := c2.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NotEqual(t, channelID, newChannelID)
+}
+
+// TestRemovedChannelIDs checks that Ids of removed channels aren't reused by
+// channels created after the server is restarted.
+func TestRemovedChannelIDs(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "accord")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "accord.db")
+
+	storage, err := accord.NewBoltStorage(path)
+	require.NoError(t, err)
+	s1, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err := s1.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s1.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c, _ := newLoggedInClient(t, serverAddr)
+	_, err = c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	removedID, err := c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, c.RemoveChannel(removedID))
+	require.NoError(t, storage.Close())
+
+	storage, err = accord.NewBoltStorage(path)
+	require.NoError(t, err)
+	defer storage.Close()
+	s2, err := accord.NewAccordServerWithStorage(storage)
+	require.NoError(t, err)
+	serverAddr, err = s2.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s2.Start()
+		t.Log("Server stopped.")
+	}()
+
+	c, _ = newLoggedInClient(t, serverAddr)
+	_, otherName := newLoggedInClient(t, serverAddr)
+	channelID, err := c.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.Greater(t, channelID, removedID)
+	directID, err := c.OpenDirectChannel(otherName)
+	require.NoError(t, err)
+	require.Greater(t, directID, channelID)
+}
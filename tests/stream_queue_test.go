@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+)
+
+// floodMessages is the number of messages sent to stalled subscribers, which
+// are large enough to fill both the transport buffers and the queues.
+const (
+	floodMessages     = 200
+	floodMessageBytes = 64 << 10
+)
+
+// newStreamQueueServer starts the server with small queues of channel streams
+// and the overflow policy. The stalled member, who never reads from its stream,
+// and the owner, who does, are both streaming with the channel.
+func newStreamQueueServer(t *testing.T, policy string) (s *accord.AccordServer, owner *accord.AccordClient, ownerComm *accord.StreamResponseCommunication, member *accord.AccordClient, memberComm *accord.StreamResponseCommunication, channelID uint64) {
+	config := accord.DefaultServerConfig()
+	config.Streams.QueueSize = 8
+	config.Streams.OverflowPolicy = policy
+	s, err := accord.NewAccordServerWithConfig(config, accord.NewMemoryStorage())
+	require.NoError(t, err)
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, _ = newLoggedInClient(t, serverAddr)
+	member, memberName := newLoggedInClient(t, serverAddr)
+	channelID, err = owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err = owner.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "welcome"})
+	receive(t, ownerComm)
+	joinChannel(t, owner, member, memberName, channelID, ownerComm)
+	require.NoError(t, member.GetChannel(channelID))
+	memberComm, err = member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "hi"})
+	receive(t, ownerComm)
+	return s, owner, ownerComm, member, memberComm, channelID
+}
+
+// flood sends messages to the channel, which are all received by the owner
+// regardless of the stalled member.
+func flood(t *testing.T, owner *accord.AccordClient, ownerComm *accord.StreamResponseCommunication, channelID uint64) uint64 {
+	content := strings.Repeat("x", floodMessageBytes)
+	var lastID uint64
+	for i := 0; i < floodMessages; i++ {
+		sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: content})
+		lastID = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).MessageID
+	}
+	return lastID
+}
+
+// TestSlowConsumerDisconnected checks that stalled clients don't hold up the
+// channel, and that they are disconnected with the cursor to resume after.
+func TestSlowConsumerDisconnected(t *testing.T) {
+	t.Parallel()
+
+	s, owner, ownerComm, member, memberComm, channelID := newStreamQueueServer(t, accord.OverflowDisconnect)
+	flood(t, owner, ownerComm, channelID)
+	metrics := s.StreamMetrics()
+	require.Equal(t, uint64(1), metrics.DisconnectedSlowConsumers)
+	require.NotZero(t, metrics.DroppedMessages)
+
+	// all messages sent before the stream was closed are still received
+	var received []uint64
+	for res := range memberComm.Resc {
+		if msg, ok := res.Msg.(*accord.UserChannelStreamResponse); ok {
+			received = append(received, msg.MessageID)
+		}
+	}
+	require.NotEmpty(t, received)
+	require.Less(t, len(received), floodMessages+1)
+	resumeAfterID := member.Channels[channelID].ResumeAfterID
+	require.Equal(t, received[len(received)-1], resumeAfterID)
+
+	// the rest is fetched after the cursor
+	fetched := 0
+	for afterID := resumeAfterID; ; {
+		n, err := member.GetMessages(channelID, 0, afterID, 32)
+		require.NoError(t, err)
+		if n == 0 {
+			break
+		}
+		fetched += n
+		messages := member.Channels[channelID].Messages
+		afterID = messages[len(messages)-1].MessageID
+	}
+	require.Equal(t, floodMessages+1-len(received), fetched)
+
+	// the client resumes streaming with the channel
+	memberComm, err := member.Subscribe(channelID)
+	require.NoError(t, err)
+	sendUserMessage(t, member, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "back"})
+	require.Equal(t, "back", receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse).GetNewAndUpdateUserMsg().Content)
+	receive(t, ownerComm)
+}
+
+// TestSlowConsumerDropOldest checks that the oldest responses are dropped for
+// stalled clients, which keep streaming and receive the newest ones.
+func TestSlowConsumerDropOldest(t *testing.T) {
+	t.Parallel()
+
+	s, owner, ownerComm, _, memberComm, channelID := newStreamQueueServer(t, accord.OverflowDropOldest)
+	lastID := flood(t, owner, ownerComm, channelID)
+	metrics := s.StreamMetrics()
+	require.Zero(t, metrics.DisconnectedSlowConsumers)
+	require.NotZero(t, metrics.DroppedMessages)
+
+	received := 0
+	for {
+		msg, ok := receive(t, memberComm).Msg.(*accord.UserChannelStreamResponse)
+		if !ok {
+			continue
+		}
+		received++
+		if msg.MessageID == lastID {
+			break
+		}
+	}
+	require.Less(t, received, floodMessages+1)
+	require.Equal(t, uint64(floodMessages+1)-uint64(received), metrics.DroppedMessages)
+}
+
+// TestSlowConsumerHandlerExits checks that streams of stalled clients, which
+// never read again, are closed without waiting for them, so that the clients
+// go offline.
+func TestSlowConsumerHandlerExits(t *testing.T) {
+	t.Parallel()
+
+	s, owner, ownerComm, _, _, channelID := newStreamQueueServer(t, accord.OverflowDisconnect)
+	flood(t, owner, ownerComm, channelID)
+	require.Equal(t, uint64(1), s.StreamMetrics().DisconnectedSlowConsumers)
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, owner.GetChannel(channelID))
+		offline := 0
+		for _, presence := range owner.Channels[channelID].Presences {
+			if presence.Status == accord.OfflinePresenceStatus {
+				offline++
+			}
+		}
+		return offline == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// TestSubscribeWhileSending checks that streams, which are opened while
+// messages are being sent, receive all the messages after the first one they
+// receive, without gaps.
+func TestSubscribeWhileSending(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, _ := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	require.NoError(t, owner.GetChannel(channelID))
+	ownerComm, err := owner.Subscribe(channelID)
+	require.NoError(t, err)
+
+	// messages keep being sent until all the readers have subscribed, and
+	// then the last one is sent
+	const readers = 4
+	readerComms := make(chan *accord.StreamResponseCommunication, readers)
+	for i := 0; i < readers; i++ {
+		reader, _ := newLoggedInClient(t, serverAddr)
+		require.NoError(t, reader.GetChannel(channelID))
+		go func(i int) {
+			time.Sleep(time.Duration(i) * 5 * time.Millisecond)
+			comm, err := reader.Subscribe(channelID)
+			if err != nil {
+				t.Errorf("cannot subscribe: %v", err)
+			}
+			readerComms <- comm
+		}(i)
+	}
+
+	var comms []*accord.StreamResponseCommunication
+	var lastID uint64
+	for len(comms) < readers {
+		select {
+		case comm := <-readerComms:
+			require.NotNil(t, comm)
+			comms = append(comms, comm)
+		default:
+		}
+		sendUserMessage(t, owner, channelID, &accord.NewMessageUserChannelStreamRequest{Content: "news"})
+		lastID = receive(t, ownerComm).Msg.(*accord.UserChannelStreamResponse).MessageID
+	}
+	for _, comm := range comms {
+		var prevID uint64
+		for prevID != lastID {
+			id := receive(t, comm).Msg.(*accord.UserChannelStreamResponse).MessageID
+			if prevID != 0 {
+				require.Equal(t, prevID+1, id)
+			}
+			prevID = id
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/qvntm/accord"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stressUsers  = 6
+	stressRounds = 10
+	// stressSignUpUsers are created one by one, since hashing of passwords
+	// is slow, especially with -race
+	stressSignUpUsers = 3
+)
+
+// drain reads all the responses from the stream, so that it never falls behind.
+func drain(resComm *accord.StreamResponseCommunication) {
+	go func() {
+		for range resComm.Resc {
+		}
+	}()
+}
+
+// stressMember joins the channel with the invite code, and then sends
+// messages to it while reading the state of the server.
+func stressMember(c *accord.AccordClient, username string, code string, channelID uint64, ownerName string) error {
+	if _, err := c.RedeemInviteCode(code); err != nil {
+		return fmt.Errorf("cannot redeem invite code: %w", err)
+	}
+	if err := c.GetChannel(channelID); err != nil {
+		return err
+	}
+	resComm, err := c.Subscribe(channelID)
+	if err != nil {
+		return err
+	}
+	drain(resComm)
+
+	for i := 0; i < stressRounds; i++ {
+		if err := c.Send(&accord.ChannelStreamRequest{
+			ChannelID: channelID,
+			Msg: &accord.UserChannelStreamRequest{
+				UserMsg: &accord.NewMessageUserChannelStreamRequest{Content: fmt.Sprintf("%s #%d", username, i)},
+			},
+		}); err != nil {
+			return err
+		}
+		status := accord.OnlinePresenceStatus
+		if i%2 == 0 {
+			status = accord.IdlePresenceStatus
+		}
+		if _, err := c.SetPresence(status, username); err != nil {
+			return fmt.Errorf("cannot set presence: %w", err)
+		}
+		if err := c.GetChannels(); err != nil {
+			return fmt.Errorf("cannot get channels: %w", err)
+		}
+		if err := c.GetChannel(channelID); err != nil {
+			return fmt.Errorf("cannot get channel: %w", err)
+		}
+		if _, err := c.GetMessagesWithSeenBy(channelID, 0, 0, 10); err != nil {
+			return fmt.Errorf("cannot get messages: %w", err)
+		}
+		if messages := c.Channels[channelID].Messages; len(messages) > 0 {
+			if err := c.Send(&accord.ChannelStreamRequest{
+				ChannelID: channelID,
+				Msg: &accord.UserChannelStreamRequest{
+					UserMsg: &accord.MarkReadUserChannelStreamRequest{MessageID: messages[len(messages)-1].MessageID},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if _, err := c.OpenDirectChannel(ownerName); err != nil {
+			return fmt.Errorf("cannot open direct channel: %w", err)
+		}
+		if _, err := c.GetInvites(); err != nil {
+			return fmt.Errorf("cannot get invites: %w", err)
+		}
+	}
+	return nil
+}
+
+// stressSignUps creates new users, while others use the server.
+func stressSignUps(serverAddr string) error {
+	for i := 0; i < stressSignUpUsers; i++ {
+		c := accord.NewAccordClient(12345)
+		if err := c.Connect(serverAddr); err != nil {
+			return err
+		}
+		username := accord.GetRandUsername()
+		password := accord.GetRandPassword()
+		if err := c.CreateUser(username, password); err != nil {
+			return fmt.Errorf("cannot create user %s: %w", username, err)
+		}
+		if err := c.Login(username, password); err != nil {
+			return fmt.Errorf("cannot log in as %s: %w", username, err)
+		}
+		if err := c.GetChannels(); err != nil {
+			return fmt.Errorf("cannot get channels: %w", err)
+		}
+	}
+	return nil
+}
+
+// stressOwner changes the channel, its invite codes and other channels of the
+// owner, while members are streaming with the channel.
+func stressOwner(owner *accord.AccordClient, channelID uint64) error {
+	if err := owner.GetChannel(channelID); err != nil {
+		return err
+	}
+	resComm, err := owner.Subscribe(channelID)
+	if err != nil {
+		return err
+	}
+	drain(resComm)
+
+	for i := 0; i < stressRounds; i++ {
+		for _, msg := range []*accord.ChannelConfigMessage{
+			{Msg: &accord.NameChannelConfigMessage{NewChannelName: accord.GetRandChannelName()}},
+			{Msg: &accord.DefineRoleChannelConfigMessage{
+				Definition: accord.RoleDefinition{
+					Name:        fmt.Sprintf("role #%d", i),
+					Permissions: []accord.Permission{accord.ReadPermission, accord.WritePermission},
+				},
+			}},
+		} {
+			if err := owner.Send(&accord.ChannelStreamRequest{ChannelID: channelID, Msg: msg}); err != nil {
+				return err
+			}
+		}
+
+		inviteCode, err := owner.CreateInviteCode(channelID, accord.MemberRole, time.Now().Add(time.Hour), 1)
+		if err != nil {
+			return fmt.Errorf("cannot create invite code: %w", err)
+		}
+		if _, err := owner.GetInviteCodes(channelID); err != nil {
+			return fmt.Errorf("cannot get invite codes: %w", err)
+		}
+		if err := owner.RevokeInviteCode(channelID, inviteCode.Code); err != nil {
+			return fmt.Errorf("cannot revoke invite code: %w", err)
+		}
+
+		// other channels are streamed with until they are removed
+		tempID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+		if err != nil {
+			return fmt.Errorf("cannot create channel: %w", err)
+		}
+		if err := owner.GetChannel(tempID); err != nil {
+			return err
+		}
+		tempComm, err := owner.Subscribe(tempID)
+		if err != nil {
+			return err
+		}
+		drain(tempComm)
+		if err := owner.Send(&accord.ChannelStreamRequest{
+			ChannelID: tempID,
+			Msg: &accord.UserChannelStreamRequest{
+				UserMsg: &accord.NewMessageUserChannelStreamRequest{Content: "soon gone"},
+			},
+		}); err != nil {
+			return err
+		}
+		if err := owner.GetChannels(); err != nil {
+			return fmt.Errorf("cannot get channels: %w", err)
+		}
+		if err := owner.RemoveChannel(tempID); err != nil {
+			return fmt.Errorf("cannot remove channel: %w", err)
+		}
+	}
+	return nil
+}
+
+// TestConcurrentServerState runs clients, which change and read the state of
+// the server at the same time. Data races are reported with -race.
+func TestConcurrentServerState(t *testing.T) {
+	t.Parallel()
+
+	s := accord.NewAccordServer()
+	serverAddr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go func() {
+		s.Start()
+		t.Log("Server stopped.")
+	}()
+
+	owner, ownerName := newLoggedInClient(t, serverAddr)
+	channelID, err := owner.CreateChannel(accord.GetRandChannelName(), true)
+	require.NoError(t, err)
+	code, err := owner.CreateInviteCode(channelID, accord.MemberRole, time.Time{}, 0)
+	require.NoError(t, err)
+	watcher, _ := newLoggedInClient(t, serverAddr)
+	serverComm, err := watcher.SubscribeToServer()
+	require.NoError(t, err)
+	go func() {
+		for range serverComm.Resc {
+		}
+	}()
+
+	members := make([]*accord.AccordClient, stressUsers)
+	memberNames := make([]string, stressUsers)
+	for i := range members {
+		members[i], memberNames[i] = newLoggedInClient(t, serverAddr)
+	}
+
+	errc := make(chan error, stressUsers+2)
+	for i := range members {
+		go func(c *accord.AccordClient, username string) {
+			errc <- stressMember(c, username, code.Code, channelID, ownerName)
+		}(members[i], memberNames[i])
+	}
+	go func() {
+		errc <- stressOwner(owner, channelID)
+	}()
+	go func() {
+		errc <- stressSignUps(serverAddr)
+	}()
+	for i := 0; i < stressUsers+2; i++ {
+		require.NoError(t, <-errc)
+	}
+
+	require.NoError(t, owner.GetChannel(channelID))
+	require.Len(t, owner.Channels[channelID].Users, stressUsers+1)
+	n, err := owner.GetMessages(channelID, 0, 0, 2*stressUsers*stressRounds)
+	require.NoError(t, err)
+	require.Equal(t, stressUsers*stressRounds, n)
+	require.NoError(t, owner.GetChannels())
+	require.Len(t, owner.Channels, 1+stressUsers)
+}
@@ -0,0 +1,227 @@
+package accord
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// denylistExpiryInterval is how often the denylist forgets about tokens,
+// which have expired.
+const denylistExpiryInterval = time.Minute
+
+// TokenDenylist keeps revoked tokens until they expire. Revocations are
+// persisted in the storage, so that revoked tokens stay revoked after restarts
+// of the server. Revoking all sessions of a user revokes all the tokens issued
+// to the user before, and since tokens issued in the same second cannot be
+// told apart by their issue times, the denylist also keeps track of the
+// issued tokens until they expire and revokes them one by one.
+type TokenDenylist struct {
+	mutex   sync.Mutex
+	storage Storage
+	// maxTokenTTL is the lifetime of the longest living tokens, after which
+	// revocations of all sessions of users don't revoke anything anymore
+	maxTokenTTL time.Duration
+	// issued maps usernames to Ids of their issued tokens and expiration times
+	issued map[string]map[string]time.Time
+	// revoked maps Ids of revoked tokens to their expiration times
+	revoked map[string]time.Time
+	// notBefore maps usernames to the times their sessions have been revoked
+	notBefore map[string]time.Time
+	// donec is closed to stop the expiry loop
+	donec    chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTokenDenylist returns a new empty denylist, which persists revocations
+// in the storage and forgets them once tokens, which live at most
+// maxTokenTTL, have expired. Revocations, which are already in the storage,
+// are loaded by Load.
+func NewTokenDenylist(storage Storage, maxTokenTTL time.Duration) *TokenDenylist {
+	d := &TokenDenylist{
+		storage:     storage,
+		maxTokenTTL: maxTokenTTL,
+		issued:      make(map[string]map[string]time.Time),
+		revoked:     make(map[string]time.Time),
+		notBefore:   make(map[string]time.Time),
+		donec:       make(chan struct{}),
+	}
+	go d.expiryLoop()
+	return d
+}
+
+// Stop stops forgetting expired tokens. It is safe to call it more than once.
+func (d *TokenDenylist) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.donec)
+	})
+}
+
+// expiryLoop periodically forgets expired tokens until the denylist is stopped.
+func (d *TokenDenylist) expiryLoop() {
+	ticker := time.NewTicker(denylistExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.donec:
+			return
+		case <-ticker.C:
+			d.mutex.Lock()
+			d.removeExpired()
+			d.mutex.Unlock()
+		}
+	}
+}
+
+// Load replaces all the revocations of the denylist with the ones from the storage.
+func (d *TokenDenylist) Load() error {
+	tokens, err := d.storage.RevokedTokens()
+	if err != nil {
+		return err
+	}
+	revocations, err := d.storage.UserRevocations()
+	if err != nil {
+		return err
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.revoked = make(map[string]time.Time, len(tokens))
+	for _, token := range tokens {
+		d.revoked[token.ID] = token.ExpiresAt
+	}
+	d.notBefore = make(map[string]time.Time, len(revocations))
+	for _, revocation := range revocations {
+		d.notBefore[revocation.Username] = revocation.NotBefore
+	}
+	d.removeExpired()
+	return nil
+}
+
+// Issue remembers the token, so that it can be revoked with all the other sessions of the user.
+func (d *TokenDenylist) Issue(claims *UserClaims) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	tokens, ok := d.issued[claims.Username]
+	if !ok {
+		tokens = make(map[string]time.Time)
+		d.issued[claims.Username] = tokens
+	}
+	tokens[claims.Id] = time.Unix(claims.ExpiresAt, 0)
+}
+
+// Revoke revokes the token until it expires.
+func (d *TokenDenylist) Revoke(claims *UserClaims) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.revoke(claims.Username, claims.Id, time.Unix(claims.ExpiresAt, 0))
+}
+
+// RevokeOnce revokes the token and reports whether it hadn't been revoked
+// before, so that only one of concurrent callers can use the token.
+func (d *TokenDenylist) RevokeOnce(claims *UserClaims) (bool, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.isRevoked(claims) {
+		return false, nil
+	}
+	if err := d.revoke(claims.Username, claims.Id, time.Unix(claims.ExpiresAt, 0)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RevokeAll revokes all the tokens issued to the user.
+func (d *TokenDenylist) RevokeAll(username string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := time.Now()
+	revocation := &UserRevocationRecord{
+		Username:  username,
+		NotBefore: now,
+	}
+	if err := d.storage.SaveUserRevocation(revocation); err != nil {
+		return err
+	}
+	d.notBefore[username] = now
+	// tokens issued in the same second as the revocation aren't revoked by it
+	for id, expiresAt := range d.issued[username] {
+		if err := d.revoke(username, id, expiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRevoked checks whether the token has been revoked.
+func (d *TokenDenylist) IsRevoked(claims *UserClaims) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.isRevoked(claims)
+}
+
+// isRevoked has to be called with the mutex held.
+func (d *TokenDenylist) isRevoked(claims *UserClaims) bool {
+	if _, ok := d.revoked[claims.Id]; ok {
+		return true
+	}
+	notBefore, ok := d.notBefore[claims.Username]
+	return ok && time.Unix(claims.IssuedAt, 0).Before(notBefore.Truncate(time.Second))
+}
+
+// revoke persists the revocation of the token of the user. It has to be
+// called with the mutex held.
+func (d *TokenDenylist) revoke(username string, id string, expiresAt time.Time) error {
+	token := &RevokedTokenRecord{
+		ID:        id,
+		ExpiresAt: expiresAt,
+	}
+	if err := d.storage.SaveRevokedToken(token); err != nil {
+		return err
+	}
+	d.revoked[id] = expiresAt
+	if tokens, ok := d.issued[username]; ok {
+		delete(tokens, id)
+	}
+	return nil
+}
+
+// removeExpired forgets about the tokens, which are rejected anyway because
+// they have expired, and about revocations of all sessions of users, which
+// have been issued before them. It has to be called with the mutex held.
+func (d *TokenDenylist) removeExpired() {
+	now := time.Now()
+	for username, notBefore := range d.notBefore {
+		// tokens issued before notBefore expire at most maxTokenTTL after it
+		if now.After(notBefore.Add(d.maxTokenTTL)) {
+			if err := d.storage.RemoveUserRevocation(username); err != nil {
+				log.Printf("Cannot remove expired revocation of sessions of %s: %v", username, err)
+				continue
+			}
+			delete(d.notBefore, username)
+		}
+	}
+	for id, expiresAt := range d.revoked {
+		if now.After(expiresAt) {
+			if err := d.storage.RemoveRevokedToken(id); err != nil {
+				log.Printf("Cannot remove expired token %s: %v", id, err)
+				continue
+			}
+			delete(d.revoked, id)
+		}
+	}
+	for username, tokens := range d.issued {
+		for id, expiresAt := range tokens {
+			if now.After(expiresAt) {
+				delete(tokens, id)
+			}
+		}
+		if len(tokens) == 0 {
+			delete(d.issued, username)
+		}
+	}
+}
@@ -1,13 +1,20 @@
 package accord
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/qvntm/accord/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func getChannelStreamRequestUserMessageNewUserMsg(m *NewMessageUserChannelStreamRequest) *pb.ChannelStreamRequest_UserMessage_NewUserMsg {
 	return &pb.ChannelStreamRequest_UserMessage_NewUserMsg{
 		NewUserMsg: &pb.ChannelStreamRequest_UserMessage_NewUserMessage{
 			Content: m.Content,
+			ReplyTo: m.ReplyTo,
 		},
 	}
 }
@@ -29,6 +36,32 @@ func getChannelStreamRequestUserMessageDeleteUserMsg(m *DeleteMessageUserChannel
 	}
 }
 
+func getChannelStreamRequestUserMessageAddReactionMsg(m *AddReactionUserChannelStreamRequest) *pb.ChannelStreamRequest_UserMessage_AddReactionMsg {
+	return &pb.ChannelStreamRequest_UserMessage_AddReactionMsg{
+		AddReactionMsg: &pb.ChannelStreamRequest_UserMessage_AddReactionUserMessage{
+			MessageId: m.MessageID,
+			Emoji:     m.Emoji,
+		},
+	}
+}
+
+func getChannelStreamRequestUserMessageRemoveReactionMsg(m *RemoveReactionUserChannelStreamRequest) *pb.ChannelStreamRequest_UserMessage_RemoveReactionMsg {
+	return &pb.ChannelStreamRequest_UserMessage_RemoveReactionMsg{
+		RemoveReactionMsg: &pb.ChannelStreamRequest_UserMessage_RemoveReactionUserMessage{
+			MessageId: m.MessageID,
+			Emoji:     m.Emoji,
+		},
+	}
+}
+
+func getChannelStreamRequestUserMessageMarkReadMsg(m *MarkReadUserChannelStreamRequest) *pb.ChannelStreamRequest_UserMessage_MarkReadMsg {
+	return &pb.ChannelStreamRequest_UserMessage_MarkReadMsg{
+		MarkReadMsg: &pb.ChannelStreamRequest_UserMessage_MarkReadUserMessage{
+			MessageId: m.MessageID,
+		},
+	}
+}
+
 // getChannelStreamRequestUserMsg turns user request message to the similar message declared
 // by pb.go file from "pb" package.
 func getChannelStreamRequestUserMsg(m *UserChannelStreamRequest) *pb.ChannelStreamRequest_UserMsg {
@@ -52,6 +85,24 @@ func getChannelStreamRequestUserMsg(m *UserChannelStreamRequest) *pb.ChannelStre
 				UserMsg: getChannelStreamRequestUserMessageDeleteUserMsg(m.getDeleteUserMsg()),
 			},
 		}
+	case *AddReactionUserChannelStreamRequest:
+		userMsg = &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: getChannelStreamRequestUserMessageAddReactionMsg(m.getAddReactionMsg()),
+			},
+		}
+	case *RemoveReactionUserChannelStreamRequest:
+		userMsg = &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: getChannelStreamRequestUserMessageRemoveReactionMsg(m.getRemoveReactionMsg()),
+			},
+		}
+	case *MarkReadUserChannelStreamRequest:
+		userMsg = &pb.ChannelStreamRequest_UserMsg{
+			UserMsg: &pb.ChannelStreamRequest_UserMessage{
+				UserMsg: getChannelStreamRequestUserMessageMarkReadMsg(m.getMarkReadMsg()),
+			},
+		}
 	}
 	return userMsg
 }
@@ -65,10 +116,39 @@ func getChannelConfigMessageNameMsg(m *NameChannelConfigMessage) *pb.ChannelConf
 }
 
 func getChannelConfigMessageRoleMsg(m *RoleChannelConfigMessage) *pb.ChannelConfigMessage_RoleMsg {
+	role, customRoleID := getPBRole(m.Role)
 	return &pb.ChannelConfigMessage_RoleMsg{
 		RoleMsg: &pb.ChannelConfigMessage_RoleChannelConfigMessage{
-			Username: m.Username,
-			Role:     AccordToPBRoles[m.Role],
+			Username:     m.Username,
+			Role:         role,
+			CustomRoleId: customRoleID,
+		},
+	}
+}
+
+func getPBRoleDefinition(m *RoleDefinition) *pb.RoleDefinition {
+	def := &pb.RoleDefinition{
+		RoleId: int32(m.Role),
+		Name:   m.Name,
+	}
+	for _, perm := range m.Permissions {
+		def.Permissions = append(def.Permissions, AccordToPBPermissions[perm])
+	}
+	return def
+}
+
+func getChannelConfigMessageDefineRoleMsg(m *DefineRoleChannelConfigMessage) *pb.ChannelConfigMessage_DefineRoleMsg {
+	return &pb.ChannelConfigMessage_DefineRoleMsg{
+		DefineRoleMsg: &pb.ChannelConfigMessage_DefineRoleChannelConfigMessage{
+			Role: getPBRoleDefinition(&m.Definition),
+		},
+	}
+}
+
+func getChannelConfigMessageRemoveRoleMsg(m *RemoveRoleChannelConfigMessage) *pb.ChannelConfigMessage_RemoveRoleMsg {
+	return &pb.ChannelConfigMessage_RemoveRoleMsg{
+		RemoveRoleMsg: &pb.ChannelConfigMessage_RemoveRoleChannelConfigMessage{
+			RoleId: int32(m.Role),
 		},
 	}
 }
@@ -81,6 +161,87 @@ func getChannelConfigMessagePinMsg(m *PinChannelConfigMessage) *pb.ChannelConfig
 	}
 }
 
+// getPBTimestamp converts the time to protobuf timestamp, zero time is converted to nil.
+func getPBTimestamp(t time.Time) (*timestamp.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(t)
+}
+
+// getTime converts protobuf timestamp to time, nil is converted to zero time.
+func getTime(t *timestamp.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func getPBBan(m *BanRecord) (*pb.Ban, error) {
+	bannedAt, err := getPBTimestamp(m.BannedAt)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := getPBTimestamp(m.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Ban{
+		Username:  m.Username,
+		Reason:    m.Reason,
+		BannedBy:  m.BannedBy,
+		BannedAt:  bannedAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func getBanRecord(m *pb.Ban) (*BanRecord, error) {
+	for _, t := range []*timestamp.Timestamp{m.GetBannedAt(), m.GetExpiresAt()} {
+		if t != nil {
+			if err := t.CheckValid(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &BanRecord{
+		Username:  m.GetUsername(),
+		Reason:    m.GetReason(),
+		BannedBy:  m.GetBannedBy(),
+		BannedAt:  getTime(m.GetBannedAt()),
+		ExpiresAt: getTime(m.GetExpiresAt()),
+	}, nil
+}
+
+func getChannelConfigMessageKickMsg(m *KickChannelConfigMessage) *pb.ChannelConfigMessage_KickMsg {
+	return &pb.ChannelConfigMessage_KickMsg{
+		KickMsg: &pb.ChannelConfigMessage_KickChannelConfigMessage{
+			Username: m.Username,
+		},
+	}
+}
+
+func getChannelConfigMessageBanMsg(m *BanChannelConfigMessage) *pb.ChannelConfigMessage_BanMsg {
+	// times, which cannot be represented by protobuf timestamps, are rejected by the server
+	ban := &pb.Ban{
+		Username: m.Ban.Username,
+		Reason:   m.Ban.Reason,
+	}
+	ban.ExpiresAt, _ = getPBTimestamp(m.Ban.ExpiresAt)
+	return &pb.ChannelConfigMessage_BanMsg{
+		BanMsg: &pb.ChannelConfigMessage_BanChannelConfigMessage{
+			Ban: ban,
+		},
+	}
+}
+
+func getChannelConfigMessageUnbanMsg(m *UnbanChannelConfigMessage) *pb.ChannelConfigMessage_UnbanMsg {
+	return &pb.ChannelConfigMessage_UnbanMsg{
+		UnbanMsg: &pb.ChannelConfigMessage_UnbanChannelConfigMessage{
+			Username: m.Username,
+		},
+	}
+}
+
 func getChannelStreamRequestConfigMsg(m *ChannelConfigMessage) *pb.ChannelStreamRequest_ConfigMsg {
 	switch m.getMsg().(type) {
 	case *NameChannelConfigMessage:
@@ -101,6 +262,36 @@ func getChannelStreamRequestConfigMsg(m *ChannelConfigMessage) *pb.ChannelStream
 				Msg: getChannelConfigMessagePinMsg(m.getPinMsg()),
 			},
 		}
+	case *DefineRoleChannelConfigMessage:
+		return &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: getChannelConfigMessageDefineRoleMsg(m.getDefineRoleMsg()),
+			},
+		}
+	case *RemoveRoleChannelConfigMessage:
+		return &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: getChannelConfigMessageRemoveRoleMsg(m.getRemoveRoleMsg()),
+			},
+		}
+	case *KickChannelConfigMessage:
+		return &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: getChannelConfigMessageKickMsg(m.getKickMsg()),
+			},
+		}
+	case *BanChannelConfigMessage:
+		return &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: getChannelConfigMessageBanMsg(m.getBanMsg()),
+			},
+		}
+	case *UnbanChannelConfigMessage:
+		return &pb.ChannelStreamRequest_ConfigMsg{
+			ConfigMsg: &pb.ChannelConfigMessage{
+				Msg: getChannelConfigMessageUnbanMsg(m.getUnbanMsg()),
+			},
+		}
 	}
 	return nil
 }
@@ -117,14 +308,61 @@ func getChannelStreamRequest(m *ChannelStreamRequest) *pb.ChannelStreamRequest {
 			ChannelId: m.ChannelID,
 			Msg:       getChannelStreamRequestConfigMsg(m.GetConfMsg()),
 		}
+	case *EphemeralMessage:
+		return &pb.ChannelStreamRequest{
+			ChannelId: m.ChannelID,
+			Msg: &pb.ChannelStreamRequest_EphemeralMsg{
+				EphemeralMsg: getPBEphemeralMessage(m.GetEphemeralMsg()),
+			},
+		}
+	}
+	return nil
+}
+
+// getPBEphemeralMessage turns the ephemeral message to the similar message
+// declared by pb.go file from "pb" package. The username and the expiration
+// time are set by the server, so they are not sent.
+func getPBEphemeralMessage(m *EphemeralMessage) *pb.EphemeralMessage {
+	switch m.getMsg().(type) {
+	case *TypingEphemeralMessage:
+		return &pb.EphemeralMessage{
+			Msg: &pb.EphemeralMessage_TypingMsg{
+				TypingMsg: &pb.EphemeralMessage_TypingEphemeralMessage{
+					Stopped: m.GetTypingMsg().Stopped,
+				},
+			},
+		}
+	}
+	return nil
+}
+
+func getEphemeralMessage(m *pb.EphemeralMessage) *EphemeralMessage {
+	switch m.GetMsg().(type) {
+	case *pb.EphemeralMessage_TypingMsg:
+		return &EphemeralMessage{
+			Username: m.GetUsername(),
+			Msg: &TypingEphemeralMessage{
+				Stopped:   m.GetTypingMsg().GetStopped(),
+				ExpiresAt: getTime(m.GetTypingMsg().GetExpiresAt()),
+			},
+		}
+	case *pb.EphemeralMessage_PresenceMsg:
+		return &EphemeralMessage{
+			Username: m.GetUsername(),
+			Msg: &PresenceEphemeralMessage{
+				Presence: getPresence(m.GetPresenceMsg().GetPresence()),
+			},
+		}
 	}
 	return nil
 }
 
 func getNewAndUpdateMessageUserChannelStreamResponse(m *pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMessage) *NewAndUpdateMessageUserChannelStreamResponse {
 	return &NewAndUpdateMessageUserChannelStreamResponse{
-		Timestamp: m.GetTimestamp().AsTime(),
-		Content:   m.GetContent(),
+		Timestamp:   m.GetTimestamp().AsTime(),
+		Content:     m.GetContent(),
+		ReplyCount:  m.GetReplyCount(),
+		LastReplyAt: getTime(m.GetLastReplyAt()),
 	}
 }
 
@@ -132,17 +370,48 @@ func getDeleteMessageUserChannelStreamResponse(m *pb.ChannelStreamResponse_UserM
 	return &DeleteMessageUserChannelStreamResponse{}
 }
 
+func getReactionsMessageUserChannelStreamResponse(m *pb.ChannelStreamResponse_UserMessage_ReactionsUserMessage) *ReactionsMessageUserChannelStreamResponse {
+	return &ReactionsMessageUserChannelStreamResponse{
+		Reactions: getReactions(m.GetReactions()),
+	}
+}
+
+func getReadMessageUserChannelStreamResponse(m *pb.ChannelStreamResponse_UserMessage_ReadUserMessage) *ReadMessageUserChannelStreamResponse {
+	return &ReadMessageUserChannelStreamResponse{
+		Username: m.GetUsername(),
+	}
+}
+
 func getUserChannelStreamResponse(m *pb.ChannelStreamResponse_UserMessage) *UserChannelStreamResponse {
 	switch m.GetUserMsg().(type) {
 	case *pb.ChannelStreamResponse_UserMessage_NewAndUpdateUserMsg:
 		return &UserChannelStreamResponse{
-			MessageID: m.GetMessageId(),
-			UserMsg:   getNewAndUpdateMessageUserChannelStreamResponse(m.GetNewAndUpdateUserMsg()),
+			MessageID:    m.GetMessageId(),
+			Sender:       m.GetSender(),
+			ReplyTo:      m.GetReplyTo(),
+			ThreadRootID: m.GetThreadRootId(),
+			UserMsg:      getNewAndUpdateMessageUserChannelStreamResponse(m.GetNewAndUpdateUserMsg()),
 		}
 	case *pb.ChannelStreamResponse_UserMessage_DeleteUserMsg:
+		return &UserChannelStreamResponse{
+			MessageID:    m.GetMessageId(),
+			Sender:       m.GetSender(),
+			ReplyTo:      m.GetReplyTo(),
+			ThreadRootID: m.GetThreadRootId(),
+			UserMsg:      getDeleteMessageUserChannelStreamResponse(m.GetDeleteUserMsg()),
+		}
+	case *pb.ChannelStreamResponse_UserMessage_ReactionsMsg:
+		return &UserChannelStreamResponse{
+			MessageID:    m.GetMessageId(),
+			Sender:       m.GetSender(),
+			ReplyTo:      m.GetReplyTo(),
+			ThreadRootID: m.GetThreadRootId(),
+			UserMsg:      getReactionsMessageUserChannelStreamResponse(m.GetReactionsMsg()),
+		}
+	case *pb.ChannelStreamResponse_UserMessage_ReadMsg:
 		return &UserChannelStreamResponse{
 			MessageID: m.GetMessageId(),
-			UserMsg:   getDeleteMessageUserChannelStreamResponse(m.GetDeleteUserMsg()),
+			UserMsg:   getReadMessageUserChannelStreamResponse(m.GetReadMsg()),
 		}
 	}
 	return nil
@@ -157,7 +426,30 @@ func getNameChannelConfigMessage(m *pb.ChannelConfigMessage_NameChannelConfigMes
 func getRoleChannelConfigMessage(m *pb.ChannelConfigMessage_RoleChannelConfigMessage) *RoleChannelConfigMessage {
 	return &RoleChannelConfigMessage{
 		Username: m.GetUsername(),
-		Role:     PBToAccordRoles[m.GetRole()],
+		Role:     getRoleFromPB(m.GetRole(), m.GetCustomRoleId()),
+	}
+}
+
+func getRoleDefinition(m *pb.RoleDefinition) RoleDefinition {
+	def := RoleDefinition{
+		Role: Role(m.GetRoleId()),
+		Name: m.GetName(),
+	}
+	for _, perm := range m.GetPermissions() {
+		def.Permissions = append(def.Permissions, PBToAccordPermissions[perm])
+	}
+	return def
+}
+
+func getDefineRoleChannelConfigMessage(m *pb.ChannelConfigMessage_DefineRoleChannelConfigMessage) *DefineRoleChannelConfigMessage {
+	return &DefineRoleChannelConfigMessage{
+		Definition: getRoleDefinition(m.GetRole()),
+	}
+}
+
+func getRemoveRoleChannelConfigMessage(m *pb.ChannelConfigMessage_RemoveRoleChannelConfigMessage) *RemoveRoleChannelConfigMessage {
+	return &RemoveRoleChannelConfigMessage{
+		Role: Role(m.GetRoleId()),
 	}
 }
 
@@ -167,6 +459,42 @@ func getPinChannelConfigMessage(m *pb.ChannelConfigMessage_PinChannelConfigMessa
 	}
 }
 
+func getBan(m *pb.Ban) Ban {
+	return Ban{
+		Username:  m.GetUsername(),
+		Reason:    m.GetReason(),
+		BannedBy:  m.GetBannedBy(),
+		BannedAt:  getTime(m.GetBannedAt()),
+		ExpiresAt: getTime(m.GetExpiresAt()),
+	}
+}
+
+func getKickChannelConfigMessage(m *pb.ChannelConfigMessage_KickChannelConfigMessage) *KickChannelConfigMessage {
+	return &KickChannelConfigMessage{
+		Username: m.GetUsername(),
+	}
+}
+
+func getBanChannelConfigMessage(m *pb.ChannelConfigMessage_BanChannelConfigMessage) *BanChannelConfigMessage {
+	return &BanChannelConfigMessage{
+		Ban: getBan(m.GetBan()),
+	}
+}
+
+func getUnbanChannelConfigMessage(m *pb.ChannelConfigMessage_UnbanChannelConfigMessage) *UnbanChannelConfigMessage {
+	return &UnbanChannelConfigMessage{
+		Username: m.GetUsername(),
+	}
+}
+
+func getInviteCodeRedeemedChannelConfigMessage(m *pb.ChannelConfigMessage_InviteCodeRedeemedChannelConfigMessage) *InviteCodeRedeemedChannelConfigMessage {
+	return &InviteCodeRedeemedChannelConfigMessage{
+		Code:       m.GetCode(),
+		Username:   m.GetUsername(),
+		RedeemedAt: getTime(m.GetRedeemedAt()),
+	}
+}
+
 func getChannelConfigMessage(m *pb.ChannelConfigMessage) *ChannelConfigMessage {
 	switch m.GetMsg().(type) {
 	case *pb.ChannelConfigMessage_NameMsg:
@@ -181,6 +509,30 @@ func getChannelConfigMessage(m *pb.ChannelConfigMessage) *ChannelConfigMessage {
 		return &ChannelConfigMessage{
 			Msg: getPinChannelConfigMessage(m.GetPinMsg()),
 		}
+	case *pb.ChannelConfigMessage_DefineRoleMsg:
+		return &ChannelConfigMessage{
+			Msg: getDefineRoleChannelConfigMessage(m.GetDefineRoleMsg()),
+		}
+	case *pb.ChannelConfigMessage_RemoveRoleMsg:
+		return &ChannelConfigMessage{
+			Msg: getRemoveRoleChannelConfigMessage(m.GetRemoveRoleMsg()),
+		}
+	case *pb.ChannelConfigMessage_KickMsg:
+		return &ChannelConfigMessage{
+			Msg: getKickChannelConfigMessage(m.GetKickMsg()),
+		}
+	case *pb.ChannelConfigMessage_BanMsg:
+		return &ChannelConfigMessage{
+			Msg: getBanChannelConfigMessage(m.GetBanMsg()),
+		}
+	case *pb.ChannelConfigMessage_UnbanMsg:
+		return &ChannelConfigMessage{
+			Msg: getUnbanChannelConfigMessage(m.GetUnbanMsg()),
+		}
+	case *pb.ChannelConfigMessage_InviteCodeRedeemedMsg:
+		return &ChannelConfigMessage{
+			Msg: getInviteCodeRedeemedChannelConfigMessage(m.GetInviteCodeRedeemedMsg()),
+		}
 	}
 	return nil
 }
@@ -195,6 +547,167 @@ func getChannelStreamResponse(m *pb.ChannelStreamResponse) *ChannelStreamRespons
 		return &ChannelStreamResponse{
 			Msg: getChannelConfigMessage(m.GetConfigMsg()),
 		}
+	case *pb.ChannelStreamResponse_EphemeralMsg:
+		return &ChannelStreamResponse{
+			Msg: getEphemeralMessage(m.GetEphemeralMsg()),
+		}
+	case *pb.ChannelStreamResponse_ChannelRemovedMsg:
+		return &ChannelStreamResponse{
+			Msg: &ChannelRemovedMessage{
+				RemovedBy: m.GetChannelRemovedMsg().GetRemovedBy(),
+			},
+		}
+	case *pb.ChannelStreamResponse_ErrorMsg:
+		return &ChannelStreamResponse{
+			Msg: &ErrorMessage{
+				Code:    codes.Code(m.GetErrorMsg().GetCode()),
+				Message: m.GetErrorMsg().GetMessage(),
+			},
+		}
+	}
+	return nil
+}
+
+// getPBErrorResponse converts the error of the request to the response sent
+// to the user, who has made it. Errors without gRPC status have Unknown code.
+func getPBErrorResponse(err error) *pb.ChannelStreamResponse {
+	st := status.Convert(err)
+	return &pb.ChannelStreamResponse{
+		Msg: &pb.ChannelStreamResponse_ErrorMsg{
+			ErrorMsg: &pb.ChannelStreamResponse_ErrorMessage{
+				Code:    int32(st.Code()),
+				Message: st.Message(),
+			},
+		},
+	}
+}
+
+func getPBMessage(m *MessageRecord) (*pb.Message, error) {
+	timestamp, err := ptypes.TimestampProto(m.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	lastReplyAt, err := getPBTimestamp(m.LastReplyAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Message{
+		MessageId:    m.MessageID,
+		Timestamp:    timestamp,
+		Content:      m.Content,
+		Sender:       m.Sender,
+		ReplyTo:      m.ReplyTo,
+		ThreadRootId: m.ThreadRootID,
+		ReplyCount:   m.ReplyCount,
+		LastReplyAt:  lastReplyAt,
+		Reactions:    getPBReactions(m.Reactions),
+	}, nil
+}
+
+func getMessage(m *pb.Message) Message {
+	return Message{
+		MessageID:    m.GetMessageId(),
+		Timestamp:    m.GetTimestamp().AsTime(),
+		Sender:       m.GetSender(),
+		Content:      m.GetContent(),
+		ReplyTo:      m.GetReplyTo(),
+		ThreadRootID: m.GetThreadRootId(),
+		ReplyCount:   m.GetReplyCount(),
+		LastReplyAt:  getTime(m.GetLastReplyAt()),
+		Reactions:    getReactions(m.GetReactions()),
+		SeenBy:       m.GetSeenBy(),
+	}
+}
+
+func getPBReactions(reactions []ReactionRecord) []*pb.Reaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+	pbReactions := make([]*pb.Reaction, len(reactions))
+	for i, reaction := range reactions {
+		pbReactions[i] = &pb.Reaction{
+			Emoji:     reaction.Emoji,
+			Count:     uint32(len(reaction.Usernames)),
+			Usernames: append([]string(nil), reaction.Usernames...),
+		}
+	}
+	return pbReactions
+}
+
+func getReactions(pbReactions []*pb.Reaction) []Reaction {
+	if len(pbReactions) == 0 {
+		return nil
+	}
+	reactions := make([]Reaction, len(pbReactions))
+	for i, reaction := range pbReactions {
+		reactions[i] = Reaction{
+			Emoji:     reaction.GetEmoji(),
+			Count:     reaction.GetCount(),
+			Usernames: reaction.GetUsernames(),
+		}
+	}
+	return reactions
+}
+
+func getChannelActionServerStreamResponse(m *pb.ServerStreamResponse_ChannelAction) *ChannelActionServerStreamResponse {
+	res := &ChannelActionServerStreamResponse{
+		ChannelID: m.GetChannelId(),
+	}
+	switch m.GetAction().(type) {
+	case *pb.ServerStreamResponse_ChannelAction_AddChannel_:
+		res.Action = &AddChannelServerStreamResponse{
+			Name:     m.GetAddChannel().GetName(),
+			IsPublic: m.GetAddChannel().GetIsPublic(),
+			IsDirect: m.GetAddChannel().GetIsDirect(),
+		}
+	case *pb.ServerStreamResponse_ChannelAction_RemoveChannel_:
+		res.Action = &RemoveChannelServerStreamResponse{}
+	case *pb.ServerStreamResponse_ChannelAction_RenameChannel_:
+		res.Action = &RenameChannelServerStreamResponse{
+			NewName: m.GetRenameChannel().GetNewName(),
+		}
+	default:
+		return nil
+	}
+	return res
+}
+
+func getServerStreamResponse(m *pb.ServerStreamResponse) *ServerStreamResponse {
+	switch m.GetEvent().(type) {
+	case *pb.ServerStreamResponse_ChannelAction_:
+		if action := getChannelActionServerStreamResponse(m.GetChannelAction()); action != nil {
+			return &ServerStreamResponse{
+				Event: action,
+			}
+		}
 	}
 	return nil
 }
+
+func getPBJSONWebKey(m JSONWebKey) *pb.JSONWebKey {
+	return &pb.JSONWebKey{
+		Kty: m.Kty,
+		Kid: m.Kid,
+		Alg: m.Alg,
+		Use: m.Use,
+		N:   m.N,
+		E:   m.E,
+		Crv: m.Crv,
+		X:   m.X,
+		Y:   m.Y,
+	}
+}
+
+func getJSONWebKey(m *pb.JSONWebKey) JSONWebKey {
+	return JSONWebKey{
+		Kty: m.GetKty(),
+		Kid: m.GetKid(),
+		Alg: m.GetAlg(),
+		Use: m.GetUse(),
+		N:   m.GetN(),
+		E:   m.GetE(),
+		Crv: m.GetCrv(),
+		X:   m.GetX(),
+		Y:   m.GetY(),
+	}
+}
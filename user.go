@@ -40,3 +40,17 @@ func (user *User) Clone() *User {
 		hashedPassword: user.hashedPassword,
 	}
 }
+
+func (user *User) record() *UserRecord {
+	return &UserRecord{
+		Username:       user.username,
+		HashedPassword: user.hashedPassword,
+	}
+}
+
+func newUserFromRecord(record *UserRecord) *User {
+	return &User{
+		username:       record.Username,
+		hashedPassword: record.HashedPassword,
+	}
+}